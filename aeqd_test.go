@@ -0,0 +1,93 @@
+package wgs84_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/wroge/wgs84"
+	"github.com/wroge/wgs84/geod"
+	"github.com/wroge/wgs84/proj"
+)
+
+func TestAzimuthalEquidistant(t *testing.T) {
+	t.Parallel()
+
+	// EPSG Guidance Note 7-2 example of the Guam Projection.
+	guam := wgs84.GuamMapGrid()
+
+	east, north, _ := wgs84.Transform(guam.Datum.LonLat(), guam)(144.63533129166666, 13.339038461111112, 0)
+	if math.Abs(east-37712.48) > 0.01 || math.Abs(north-35242.00) > 0.01 {
+		t.Fatal(east, north)
+	}
+
+	lon, lat := guam.Projection.ToLonLat(37712.48, 35242.00, guam.Datum)
+	if math.Abs(lon-144.63533129166666) > 1e-7 || math.Abs(lat-13.339038461111112) > 1e-7 {
+		t.Fatal(lon, lat)
+	}
+
+	if !guam.Contains(144.75, 13.47) || guam.Contains(146, 15) {
+		t.Fatal("area")
+	}
+
+	def, err := wgs84.ToProj4String(guam)
+	if err != nil || !strings.Contains(def, "+guam") {
+		t.Fatal(def, err)
+	}
+
+	parsed, err := wgs84.ParsePROJ(def)
+	if err != nil || wgs84.Fingerprint(parsed) != wgs84.Fingerprint(guam) {
+		t.Fatal(def, err)
+	}
+
+	// The Modified Azimuthal Equidistant projection matches the exact
+	// projection on the island.
+	modified := proj.AzimuthalEquidistant{Lonf: 144.74875070555555, Latf: 13.472466352777777, Eastf: 50000, Northf: 50000, Modified: true}
+	exact := guam.Datum.AzimuthalEquidistant(144.74875070555555, 13.472466352777777, 50000, 50000)
+
+	for _, p := range [][2]float64{{144.63533129166666, 13.339038461111112}, {144.95, 13.65}, {144.75, 13.47}} {
+		east, north := modified.FromLonLat(p[0], p[1], guam.Datum)
+		wantE, wantN := exact.Projection.FromLonLat(p[0], p[1], guam.Datum)
+
+		if math.Abs(east-wantE) > 1e-3 || math.Abs(north-wantN) > 1e-3 {
+			t.Fatal(p, east, north, wantE, wantN)
+		}
+
+		lon, lat := modified.ToLonLat(east, north, guam.Datum)
+		if math.Abs(lon-p[0]) > 1e-9 || math.Abs(lat-p[1]) > 1e-9 {
+			t.Fatal(p, lon, lat)
+		}
+
+		east, north = guam.Projection.FromLonLat(p[0], p[1], guam.Datum)
+
+		lon, lat = guam.Projection.ToLonLat(east, north, guam.Datum)
+		if math.Abs(lon-p[0]) > 1e-9 || math.Abs(lat-p[1]) > 1e-9 {
+			t.Fatal(p, lon, lat)
+		}
+	}
+
+	crs := wgs84.WGS84().AzimuthalEquidistant(10, 50, 0, 0)
+
+	for _, c := range []struct{ az, dist float64 }{{30, 500000}, {200, 2000000}, {300, 9000000}} {
+		lon, lat, _ := geod.WGS84().Direct(10, 50, c.az, c.dist)
+
+		east, north := crs.Projection.FromLonLat(lon, lat, crs.Datum)
+		if math.Abs(math.Hypot(east, north)-c.dist) > 1e-3 ||
+			math.Abs(math.Remainder(180/math.Pi*math.Atan2(east, north)-c.az, 360)) > 1e-9 {
+			t.Fatal(c, east, north)
+		}
+
+		lon2, lat2 := crs.Projection.ToLonLat(east, north, crs.Datum)
+		if math.Abs(lon2-lon) > 1e-9 || math.Abs(lat2-lat) > 1e-9 {
+			t.Fatal(c, lon2, lat2)
+		}
+
+		if !crs.Contains(lon, lat) {
+			t.Fatal(c)
+		}
+	}
+
+	if crs.Contains(-170, -50) {
+		t.Fatal("antipode")
+	}
+}
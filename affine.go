@@ -0,0 +1,46 @@
+package wgs84
+
+import "math"
+
+// AffineTransform provides the transformation of raster pixel coordinates to
+// the coordinates of the raster's Coordinate Reference System.
+//
+// The geoTransform is a GDAL-style array of
+// [ulx, xres, xskew, uly, yskew, yres]. The pixel (0, 0) is the upper left
+// corner of the raster, so the center of a pixel is at (col+0.5, row+0.5).
+// The third coordinate is passed through.
+//
+// The result can be composed with the To methods of the
+// CoordinateReferenceSystem's in this package:
+//
+//	lon, lat, h := wgs84.UTM(32, true).To(wgs84.WGS84LonLat())(wgs84.AffineTransform(gt)(col, row, 0))
+func AffineTransform(geoTransform [6]float64) Func {
+	return func(col, row, c float64) (x, y, c2 float64) {
+		x = geoTransform[0] + col*geoTransform[1] + row*geoTransform[2]
+		y = geoTransform[3] + col*geoTransform[4] + row*geoTransform[5]
+
+		return x, y, c
+	}
+}
+
+// InverseAffineTransform provides the transformation of coordinates of the
+// raster's Coordinate Reference System to floating-point pixel coordinates.
+//
+// It is the inverse of AffineTransform and handles skewed grids. Returns NaN
+// if the geoTransform is not invertible.
+func InverseAffineTransform(geoTransform [6]float64) Func {
+	det := geoTransform[1]*geoTransform[5] - geoTransform[2]*geoTransform[4]
+
+	return func(x, y, c float64) (col, row, c2 float64) {
+		if det == 0 {
+			return math.NaN(), math.NaN(), c
+		}
+
+		x -= geoTransform[0]
+		y -= geoTransform[3]
+		col = (geoTransform[5]*x - geoTransform[2]*y) / det
+		row = (geoTransform[1]*y - geoTransform[4]*x) / det
+
+		return col, row, c
+	}
+}
@@ -0,0 +1,86 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestAlbersEqualAreaConic(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		crs                          wgs84.ProjectedReferenceSystem
+		lonf, latf, lat1, lat2, east float64
+		north                        float64
+	}{
+		{wgs84.NAD83CaliforniaAlbers(), -120, 0, 34, 40.5, 0, -4000000},
+		{wgs84.BCAlbers(), -126, 45, 50, 58.5, 1000000, 0},
+		{wgs84.CanadaAlbers(), -96, 40, 50, 70, 0, 0},
+		{wgs84.AustraliaAlbers(), 132, 0, -18, -36, 0, 0},
+	} {
+		// the false origin.
+		east, north := c.crs.Projection.FromLonLat(c.lonf, c.latf, c.crs.Datum)
+		if math.Abs(east-c.east) > 1e-6 || math.Abs(north-c.north) > 1e-6 {
+			t.Fatal(c.crs.Name(), east, north)
+		}
+
+		// the standard parallels are true to scale.
+		for _, lat := range []float64{c.lat1, c.lat2} {
+			e1, n1 := c.crs.Projection.FromLonLat(c.lonf-0.0005, lat, c.crs.Datum)
+			e2, n2 := c.crs.Projection.FromLonLat(c.lonf+0.0005, lat, c.crs.Datum)
+			ν := c.crs.Datum.A() / math.Sqrt(1-(2/c.crs.Datum.Fi()-1/c.crs.Datum.Fi()/c.crs.Datum.Fi())*math.Pow(math.Sin(radian(lat)), 2))
+
+			if k := math.Hypot(e2-e1, n2-n1) / (ν * math.Cos(radian(lat)) * radian(0.001)); math.Abs(k-1) > 1e-9 {
+				t.Fatal(c.crs.Name(), lat, k)
+			}
+		}
+
+		for lon := c.lonf - 15; lon <= c.lonf+15; lon += 2.5 {
+			for lat := c.lat2 - 20; lat <= c.lat2+20; lat += 2.5 {
+				east, north := c.crs.Projection.FromLonLat(lon, lat, c.crs.Datum)
+
+				lon2, lat2 := c.crs.Projection.ToLonLat(east, north, c.crs.Datum)
+				if math.Abs(lon2-lon) > 1e-9 || math.Abs(lat2-lat) > 1e-9 {
+					t.Fatal(c.crs.Name(), lon, lat, lon2, lat2)
+				}
+			}
+		}
+	}
+
+	// a single standard parallel at a pole is the polar aspect of the Lambert
+	// Azimuthal Equal Area projection.
+	for _, pole := range []float64{90, -90} {
+		albers := wgs84.WGS84().AlbersEqualAreaConic(0, pole, pole, pole, 0, 0)
+		laea := wgs84.WGS84().LambertAzimuthalEqualArea(0, pole, 0, 0)
+
+		for lon := -170.0; lon <= 170; lon += 20 {
+			for _, lat := range []float64{pole * 0.999, pole * 0.9, pole * 0.5, 0, -pole * 0.5} {
+				east, north := albers.Projection.FromLonLat(lon, lat, albers.Datum)
+				east2, north2 := laea.Projection.FromLonLat(lon, lat, laea.Datum)
+
+				if math.Abs(east-east2) > 1e-4 || math.Abs(north-north2) > 1e-4 {
+					t.Fatal(pole, lon, lat, east-east2, north-north2)
+				}
+
+				lon2, lat2 := albers.Projection.ToLonLat(east, north, albers.Datum)
+				if math.Abs(lat2-lat) > 1e-9 || math.Abs(lon2-lon) > 1e-9 {
+					t.Fatal(pole, lon, lat, lon2, lat2)
+				}
+			}
+		}
+
+		if east, north := albers.Projection.FromLonLat(45, pole, albers.Datum); math.Hypot(east, north) > 1e-6 {
+			t.Fatal(east, north)
+		}
+
+		if _, lat := albers.Projection.ToLonLat(0, 0, albers.Datum); lat != pole {
+			t.Fatal(lat)
+		}
+
+		if lon, lat := albers.Projection.ToLonLat(1e8, 1e8, albers.Datum); !math.IsNaN(lon) || !math.IsNaN(lat) {
+			t.Fatal(lon, lat)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestBrazilUTM(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		zone     float64
+		southern bool
+		code     int
+		lon, lat float64
+	}{
+		{23, true, 31983, -46.63, -23.55},
+		{20, true, 31980, -60.02, -3.12},
+		{20, false, 31974, -60.67, 2.82},
+		{23, false, 6210, -45, 1},
+	} {
+		crs := wgs84.BrazilUTM(c.zone, c.southern)
+
+		if crs.EPSGCode() != c.code || !crs.Contains(c.lon, c.lat) {
+			t.Fatal(c.zone, c.southern, crs.EPSGCode())
+		}
+
+		// SIRGAS 2000 and WGS84 only differ by the flattening of GRS80.
+		east, north, _ := wgs84.Transform(wgs84.WGS84LonLat(), crs)(c.lon, c.lat, 0)
+		e, n, _ := wgs84.Transform(wgs84.WGS84LonLat(), wgs84.UTM(c.zone, !c.southern))(c.lon, c.lat, 0)
+
+		if math.Abs(east-e) > 0.01 || math.Abs(north-n) > 0.01 {
+			t.Fatal(east, north, e, n)
+		}
+	}
+
+	if crs := wgs84.BrazilUTM(23, true); crs.Contains(-46.63, 1) || crs.Contains(-80, -23.55) {
+		t.Fatal(crs)
+	}
+
+	for _, crs := range []wgs84.ProjectedReferenceSystem{
+		wgs84.BrazilUTM(17, true),
+		wgs84.BrazilUTM(26, true),
+		wgs84.BrazilUTM(25, false),
+	} {
+		if crs.Projection != nil {
+			t.Fatal(crs)
+		}
+	}
+}
+
+func TestBrazilAlbers(t *testing.T) {
+	t.Parallel()
+
+	crs := wgs84.BrazilAlbers()
+
+	if east, north := crs.Projection.FromLonLat(-54, -12, crs.Datum); math.Abs(east-5000000) > 1e-6 || math.Abs(north-10000000) > 1e-6 {
+		t.Fatal(east, north)
+	}
+
+	for lon := -73.0; lon <= -26; lon += 3 {
+		for lat := -35.0; lat <= 7; lat += 3 {
+			east, north := crs.Projection.FromLonLat(lon, lat, crs.Datum)
+			lon2, lat2 := crs.Projection.ToLonLat(east, north, crs.Datum)
+
+			if math.Abs(lon2-lon) > 1e-9 || math.Abs(lat2-lat) > 1e-9 {
+				t.Fatal(lon, lat, lon2, lat2)
+			}
+
+			if _, _, _, s := wgs84.Tissot(crs, lon, lat); math.Abs(s-1) > 1e-6 {
+				t.Fatal(lon, lat, s)
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestCassiniSoldner(t *testing.T) {
+	t.Parallel()
+
+	// EPSG Guidance Note 7-2, Cassini-Soldner (Trinidad 1903 / Trinidad
+	// Grid) in Clarke's links, and the spherical formulas of Snyder for
+	// further points.
+	const link = 0.201166195164
+
+	crs := wgs84.TrinidadGrid()
+	sphere := wgs84.Helmert(6371000, math.Inf(1), 0, 0, 0, 0, 0, 0, 0).CassiniSoldner(-61.333333333333336, 10.441666666666666, 0, 0)
+
+	for _, c := range []struct {
+		crs                 wgs84.ProjectedReferenceSystem
+		lon, lat, east, nor float64
+	}{
+		{crs, -62, 10, 66644.94 * link, 82536.22 * link},
+		{sphere, -62, 10, cassiniSphere(-62, 10)[0], cassiniSphere(-62, 10)[1]},
+		{sphere, -60, 12, cassiniSphere(-60, 12)[0], cassiniSphere(-60, 12)[1]},
+		{sphere, -63, 50, cassiniSphere(-63, 50)[0], cassiniSphere(-63, 50)[1]},
+	} {
+		east, north := c.crs.Projection.FromLonLat(c.lon, c.lat, c.crs.Datum)
+		if math.Abs(east-c.east) > 0.01 || math.Abs(north-c.nor) > 0.01 {
+			t.Fatal(c.lon, c.lat, east, north, c.east, c.nor)
+		}
+
+		lon, lat := c.crs.Projection.ToLonLat(c.east, c.nor, c.crs.Datum)
+		if math.Abs(lon-c.lon) > 1e-7 || math.Abs(lat-c.lat) > 1e-7 {
+			t.Fatal(c.east, c.nor, lon, lat)
+		}
+	}
+
+	for lon := -62.0; lon <= -60.9; lon += 0.1 {
+		for lat := 9.9; lat <= 10.8; lat += 0.1 {
+			east, north := crs.Projection.FromLonLat(lon, lat, crs.Datum)
+			lon2, lat2 := crs.Projection.ToLonLat(east, north, crs.Datum)
+			east2, north2 := crs.Projection.FromLonLat(lon2, lat2, crs.Datum)
+
+			if math.Abs(east2-east) > 1e-6 || math.Abs(north2-north) > 1e-6 {
+				t.Fatal(lon, lat, east2-east, north2-north)
+			}
+		}
+	}
+}
+
+// cassiniSphere is the Cassini projection of a sphere with a radius of
+// 6371000 meters and the origin of the Trinidad Grid.
+func cassiniSphere(lon, lat float64) [2]float64 {
+	φ, λ, φ0 := radian(lat), radian(lon+61.333333333333336), radian(10.441666666666666)
+
+	return [2]float64{
+		6371000 * math.Asin(math.Cos(φ)*math.Sin(λ)),
+		6371000 * (math.Atan2(math.Tan(φ), math.Cos(λ)) - φ0),
+	}
+}
@@ -0,0 +1,49 @@
+package wgs84_test
+
+import (
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestCGCS2000(t *testing.T) {
+	t.Parallel()
+
+	to := wgs84.Transform(wgs84.CGCS2000LonLat(), wgs84.WGS84LonLat())
+
+	for lon := -180.0; lon <= 180; lon += 15 {
+		for lat := -90.0; lat <= 90; lat += 15 {
+			lon2, lat2, _ := to(lon, lat, 0)
+			if d := wgs84.Distance(lon, lat, lon2, lat2, wgs84.WGS84()); !(d < 1) {
+				t.Fatal(lon, lat, d)
+			}
+		}
+	}
+
+	for _, c := range []struct {
+		zone     float64
+		code     int
+		lonf     float64
+		name     string
+		lon, lat float64
+		notLon   float64
+	}{
+		{20, 4498, 117, "CGCS2000 / Gauss-Kruger zone 20", 116.4, 39.9, 120.5},
+		{39, 4527, 117, "CGCS2000 / 3-degree Gauss-Kruger zone 39", 116.4, 39.9, 118.6},
+	} {
+		crs := wgs84.CGCS2000GaussKruger(c.zone)
+		if crs.EPSGCode() != c.code || crs.Name() != c.name || !crs.Contains(c.lon, c.lat) || crs.Contains(c.notLon, c.lat) {
+			t.Fatal(c.zone, crs.EPSGCode(), crs.Name())
+		}
+
+		if east, north := crs.Projection.FromLonLat(c.lonf, 0, crs.Datum); east != c.zone*1000000+500000 || north != 0 {
+			t.Fatal(c.zone, east, north)
+		}
+	}
+
+	for _, zone := range []float64{12, 24, 46, 20.5} {
+		if crs := wgs84.CGCS2000GaussKruger(zone); crs.Projection != nil {
+			t.Fatal(zone)
+		}
+	}
+}
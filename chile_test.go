@@ -0,0 +1,31 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestChileUTM(t *testing.T) {
+	t.Parallel()
+
+	crs := wgs84.ChileUTM(19)
+	if crs.EPSGCode() != 0 || crs.Name() != "SSITDEF / UTM zone 19S" {
+		t.Fatal(crs.EPSGCode(), crs.Name())
+	}
+
+	if east, north := crs.Projection.FromLonLat(-69, 0, crs.Datum); east != 500000 || north != 10000000 {
+		t.Fatal(east, north)
+	}
+
+	if !crs.Contains(-70.65, -33.45) || wgs84.ChileUTM(18).Contains(-70.65, -33.45) {
+		t.Fatal("area")
+	}
+
+	for _, zone := range []float64{17, 20, 18.5, math.NaN()} {
+		if crs := wgs84.ChileUTM(zone); crs.Projection != nil {
+			t.Fatal(zone, crs)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Command wgs84 transforms coordinates between EPSG-Codes.
+//
+//	wgs84 --from EPSG:4326 --to EPSG:32632 --coords "9.0 48.0"
+//
+// Without --coords the coordinates are read from stdin, one coordinate per
+// line separated by whitespace or commas, or as a JSON array of coordinate
+// arrays. The output uses the format of the input.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/wroge/wgs84"
+)
+
+var errInvalidCoordinate = errors.New("invalid coordinate")
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "wgs84:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("wgs84", flag.ContinueOnError)
+	from := flags.String("from", "EPSG:4326", "source EPSG-Code")
+	to := flags.String("to", "EPSG:4326", "target EPSG-Code")
+	coords := flags.String("coords", "", "coordinate, read from stdin if empty")
+	inputFormat := flags.String("format", "auto", "input format: auto, text, csv or json")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	epsg := wgs84.EPSG()
+
+	fromCRS, err := lookup(epsg, *from)
+	if err != nil {
+		return err
+	}
+
+	toCRS, err := lookup(epsg, *to)
+	if err != nil {
+		return err
+	}
+
+	input := stdin
+	if *coords != "" {
+		input = strings.NewReader(*coords)
+	}
+
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return err
+	}
+
+	if *inputFormat == "auto" {
+		*inputFormat = detect(string(data))
+	}
+
+	transform := wgs84.Transform(fromCRS, toCRS)
+
+	if *inputFormat == "json" {
+		return transformJSON(data, transform, stdout)
+	}
+
+	return transformLines(string(data), *inputFormat == "csv", transform, stdout)
+}
+
+func lookup(epsg *wgs84.Repository, s string) (wgs84.CoordinateReferenceSystem, error) {
+	code, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(s)), "EPSG:"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid EPSG-Code %q", s)
+	}
+
+	crs := epsg.Code(code)
+	if crs == nil {
+		return nil, fmt.Errorf("unknown EPSG-Code %d", code)
+	}
+
+	return crs, nil
+}
+
+func detect(data string) string {
+	data = strings.TrimSpace(data)
+
+	switch {
+	case strings.HasPrefix(data, "["):
+		return "json"
+	case strings.Contains(data, ","):
+		return "csv"
+	default:
+		return "text"
+	}
+}
+
+func transformLines(data string, csv bool, transform wgs84.Func, w io.Writer) error {
+	sep := " "
+	if csv {
+		sep = ","
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if csv {
+			fields = strings.Split(line, ",")
+		}
+
+		c, err := parseCoord(fields)
+		if err != nil {
+			return fmt.Errorf("%w: %q", err, line)
+		}
+
+		a, b, h := transform(c[0], c[1], c[2])
+
+		fmt.Fprintln(w, strings.Join([]string{format(a), format(b), format(h)}, sep))
+	}
+
+	return scanner.Err()
+}
+
+func transformJSON(data []byte, transform wgs84.Func, w io.Writer) error {
+	var coords [][]float64
+
+	if err := json.Unmarshal(data, &coords); err != nil {
+		var single []float64
+
+		if json.Unmarshal(data, &single) != nil {
+			return err
+		}
+
+		coords = [][]float64{single}
+	}
+
+	result := make([][3]float64, len(coords))
+
+	for i, c := range coords {
+		if len(c) < 2 || len(c) > 3 {
+			return errInvalidCoordinate
+		}
+
+		c = append(c, 0)
+		result[i][0], result[i][1], result[i][2] = transform(c[0], c[1], c[2])
+	}
+
+	return json.NewEncoder(w).Encode(result)
+}
+
+func parseCoord(fields []string) ([3]float64, error) {
+	var c [3]float64
+
+	if len(fields) < 2 || len(fields) > 3 {
+		return c, errInvalidCoordinate
+	}
+
+	for i, f := range fields {
+		v, err := strconv.ParseFloat(strings.TrimSpace(f), 64)
+		if err != nil {
+			return c, errInvalidCoordinate
+		}
+
+		c[i] = v
+	}
+
+	return c, nil
+}
+
+func format(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
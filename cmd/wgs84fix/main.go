@@ -0,0 +1,110 @@
+// Command wgs84fix rewrites calls of deprecated functions of the wgs84
+// package.
+//
+//	wgs84fix [-w] file.go...
+//
+// It replaces wgs84.LonLat() with wgs84.WGS84LonLat() and wgs84.XYZ() with
+// wgs84.WGS84XYZ(). Without -w the rewritten files are printed to stdout.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"strconv"
+)
+
+const importPath = "github.com/wroge/wgs84"
+
+var replacements = map[string]string{
+	"LonLat": "WGS84LonLat",
+	"XYZ":    "WGS84XYZ",
+}
+
+func main() {
+	write := flag.Bool("w", false, "write result to the source files instead of stdout")
+	flag.Parse()
+
+	for _, name := range flag.Args() {
+		if err := fix(name, *write); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func fix(name string, write bool) error {
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, name, nil, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	pkg := packageName(file)
+	if pkg == "" {
+		return nil
+	}
+
+	changed := false
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		x, ok := sel.X.(*ast.Ident)
+		if !ok || x.Name != pkg {
+			return true
+		}
+
+		if r, ok := replacements[sel.Sel.Name]; ok {
+			sel.Sel.Name = r
+			changed = true
+		}
+
+		return true
+	})
+
+	if !changed && write {
+		return nil
+	}
+
+	var buf bytes.Buffer
+
+	if err := format.Node(&buf, fset, file); err != nil {
+		return err
+	}
+
+	if !write {
+		_, err = os.Stdout.Write(buf.Bytes())
+
+		return err
+	}
+
+	return os.WriteFile(name, buf.Bytes(), 0o600)
+}
+
+func packageName(file *ast.File) string {
+	for _, imp := range file.Imports {
+		p, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || p != importPath {
+			continue
+		}
+
+		if imp.Name != nil {
+			return imp.Name.Name
+		}
+
+		return path.Base(p)
+	}
+
+	return ""
+}
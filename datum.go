@@ -2,6 +2,9 @@ package wgs84
 
 import (
 	"math"
+
+	"github.com/wroge/wgs84/datum"
+	"github.com/wroge/wgs84/proj"
 )
 
 // Helmert provides a Datum specified through the major axis and the
@@ -10,14 +13,14 @@ import (
 func Helmert(a, fi, tx, ty, tz, rx, ry, rz, ds float64) Datum {
 	return Datum{
 		Spheroid: spheroid{a: a, fi: fi},
-		Transformation: helmert{
-			tx: tx,
-			ty: ty,
-			tz: tz,
-			rx: rx,
-			ry: ry,
-			rz: rz,
-			ds: ds,
+		Transformation: datum.Helmert{
+			Tx: tx,
+			Ty: ty,
+			Tz: tz,
+			Rx: rx,
+			Ry: ry,
+			Rz: rz,
+			Ds: ds,
 		},
 	}
 }
@@ -28,15 +31,7 @@ func Helmert(a, fi, tx, ty, tz, rx, ry, rz, ds float64) Datum {
 //
 // It is used worldwide.
 func WGS84() Datum {
-	return Datum{
-		Spheroid: spheroid{
-			a:  A,
-			fi: Fi,
-		},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return math.Abs(lon) <= 180 && math.Abs(lat) <= 90
-		}),
-	}
+	return Datum(datum.WGS84())
 }
 
 // ETRS89 provides a Datum similar to the European Terrestrial Reference
@@ -46,12 +41,7 @@ func WGS84() Datum {
 //
 // It is used in Europe.
 func ETRS89() Datum {
-	return Datum{
-		Spheroid: GRS80{},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return lon >= -16.1 && lon <= 40.18 && lat >= 32.88 && lat <= 84.17
-		}),
-	}
+	return Datum(datum.ETRS89())
 }
 
 // OSGB36 provides a Datum similar to the Ordnance Survey Great Britain 1936.
@@ -63,21 +53,7 @@ func ETRS89() Datum {
 //
 // It is used in Great Britain.
 func OSGB36() Datum {
-	return Datum{
-		Spheroid: Airy{},
-		Transformation: helmert{
-			tx: 446.448,
-			ty: -125.157,
-			tz: 542.06,
-			rx: 0.15,
-			ry: 0.247,
-			rz: 0.842,
-			ds: -20.489,
-		},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return lon >= -8.82 && lon <= 1.92 && lat >= 49.79 && lat <= 60.94
-		}),
-	}
+	return Datum(datum.OSGB36())
 }
 
 // MGI provides a Datum similar to the Militar-Geographische Institut.
@@ -89,21 +65,7 @@ func OSGB36() Datum {
 //
 // It is used in Austria.
 func MGI() Datum {
-	return Datum{
-		Spheroid: Bessel{},
-		Transformation: helmert{
-			tx: 577.326,
-			ty: 90.129,
-			tz: 463.919,
-			rx: 5.137,
-			ry: 1.474,
-			rz: 5.297,
-			ds: 2.4232,
-		},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return lon >= 9.53 && lon <= 17.17 && lat >= 46.4 && lat <= 49.02
-		}),
-	}
+	return Datum(datum.MGI())
 }
 
 // DHDN2001 provides a Datum similar to the Deutsches Hauptdreiecksnetz 2001.
@@ -115,21 +77,7 @@ func MGI() Datum {
 //
 // It is used in Germay.
 func DHDN2001() Datum {
-	return Datum{
-		Spheroid: Bessel{},
-		Transformation: helmert{
-			tx: 598.1,
-			ty: 73.7,
-			tz: 418.2,
-			rx: 0.202,
-			ry: 0.045,
-			rz: -2.455,
-			ds: 6.7,
-		},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return lon >= 5.87 && lon <= 13.84 && lat >= 47.27 && lat <= 55.09
-		}),
-	}
+	return Datum(datum.DHDN2001())
 }
 
 // RGF93 provides a Datum similar to the Réseau géodésique français 1993.
@@ -138,12 +86,7 @@ func DHDN2001() Datum {
 //
 // It is used in France.
 func RGF93() Datum {
-	return Datum{
-		Spheroid: GRS80{},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return lon >= -9.86 && lon <= 10.38 && lat >= 41.15 && lat <= 51.56
-		}),
-	}
+	return Datum(datum.RGF93())
 }
 
 // NAD83 provides a Datum similar to the North American Datum 1983.
@@ -152,23 +95,391 @@ func RGF93() Datum {
 //
 // It is used in North-America.
 func NAD83() Datum {
-	return Datum{
-		Spheroid: GRS80{},
-		Area: AreaFunc(func(lon, lat float64) bool {
-			return lon >= -172.54 && lon <= -47.74 && lat >= 23.81 && lat <= 86.46
-		}),
-	}
+	return Datum(datum.NAD83())
+}
+
+// NAD83CORS96 provides a Datum similar to the North American Datum 1983
+// realized by the Continuously Operating Reference Stations in 1996.
+//
+// It's based on the GRS80 Spheroid and a 14-parameter-Helmert-Transformation
+// from ITRF2000 evaluated at the epoch of the coordinates in decimal years.
+// The EPSG Repository uses the epoch 2002.0 of the realization.
+//
+// It is used in North-America.
+func NAD83CORS96(epoch float64) Datum {
+	return Datum(datum.NAD83CORS96(epoch))
+}
+
+// SIRGAS95 provides a Datum similar to the Sistema de Referencia Geocéntrico
+// para las Américas 1995.
+//
+// It's based on the GRS80 Spheroid and a 7-parameter-Helmert-Transformation
+// from ITRF94.
+//
+// It is used in South America.
+func SIRGAS95() Datum {
+	return Datum(datum.SIRGAS95())
+}
+
+// AFREF provides a Datum similar to the African Geodetic Reference Frame.
+//
+// It's based on the GRS80 Spheroid and realized in ITRF2005.
+//
+// It is used in Africa.
+func AFREF() Datum {
+	return Datum(datum.AFREF())
+}
+
+// RGAF09 provides a Datum similar to the Réseau Géodésique des Antilles
+// Françaises 2009.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in the French Antilles.
+func RGAF09() Datum {
+	return Datum(datum.RGAF09())
+}
+
+// JAD2001 provides a Datum similar to the Jamaica 2001.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in Jamaica.
+func JAD2001() Datum {
+	return Datum(datum.JAD2001())
+}
+
+// CIGD11 provides a Datum similar to the Cayman Islands Geodetic Datum 2011.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in the Cayman Islands.
+func CIGD11() Datum {
+	return Datum(datum.CIGD11())
+}
+
+// MexicoITRF2008 provides a Datum similar to the Mexico ITRF2008.
+//
+// It's based on the GRS80 Spheroid. The EPSG name is Mexico_ITRF2008, the
+// underscore is dropped as Go names are mixed caps.
+//
+// It is used in Mexico.
+func MexicoITRF2008() Datum {
+	return Datum(datum.MexicoITRF2008())
+}
+
+// POSGAR07 provides a Datum similar to the Posiciones Geodésicas Argentinas
+// 2007.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in Argentina.
+func POSGAR07() Datum {
+	return Datum(datum.POSGAR07())
+}
+
+// PSAD56 provides a Datum similar to the Provisional South American Datum
+// 1956.
+//
+// It's based on the International1924 Spheroid and a 3-parameter-Helmert-
+// Transformation with the parameters for Peru: -279,175,-379.
+//
+// It is used in South America.
+func PSAD56() Datum {
+	return Datum(datum.PSAD56())
+}
+
+// MAGNA provides a Datum similar to the Marco Geocéntrico Nacional de
+// Referencia.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in Colombia.
+func MAGNA() Datum {
+	return Datum(datum.MAGNA())
+}
+
+// SSITDEF provides a Datum similar to the Sistema de Referencia Geocéntrico
+// de Chile 2009.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in Chile.
+func SSITDEF() Datum {
+	return Datum(datum.SSITDEF())
+}
+
+// REGVEN provides a Datum similar to the Red Geocéntrica Venezolana.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in Venezuela.
+func REGVEN() Datum {
+	return Datum(datum.REGVEN())
+}
+
+// GDM2000 provides a Datum similar to the Geodetic Datum of Malaysia 2000.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in Malaysia.
+func GDM2000() Datum {
+	return Datum(datum.GDM2000())
+}
+
+// Trinidad1903 provides a Datum similar to the Trinidad 1903.
+//
+// It's based on the Clarke1858 Spheroid.
+//
+// It is used in Trinidad and Tobago.
+func Trinidad1903() Datum {
+	return Datum(datum.Trinidad1903())
+}
+
+// Ireland1965 provides a Datum similar to the Geodetic Datum of 1965 (TM75).
+//
+// It's based on the AiryModified Spheroid.
+//
+// It is used in Ireland and Northern Ireland.
+func Ireland1965() Datum {
+	return Datum(datum.Ireland1965())
+}
+
+// NTF provides a Datum similar to the Nouvelle Triangulation Française.
+//
+// It's based on the Clarke1880IGN Spheroid.
+//
+// It is used in France.
+func NTF() Datum {
+	return Datum(datum.NTF())
+}
+
+// ED50 provides a Datum similar to the European Datum 1950.
+//
+// It's based on the International1924 Spheroid.
+//
+// It is used in Europe.
+func ED50() Datum {
+	return Datum(datum.ED50())
+}
+
+// Guam1963 provides a Datum similar to the Guam 1963.
+//
+// It's based on the Clarke1866 Spheroid.
+//
+// It is used in Guam.
+func Guam1963() Datum {
+	return Datum(datum.Guam1963())
+}
+
+// MonteMario provides a Datum similar to the Monte Mario (Roma40).
+//
+// It's based on the International1924 Spheroid.
+//
+// It is used in Italy.
+func MonteMario() Datum {
+	return Datum(datum.MonteMario())
+}
+
+// SAD69 provides a Datum similar to the South American Datum 1969.
+//
+// It's based on the GRS67Modified Spheroid.
+//
+// It is used in South America.
+func SAD69() Datum {
+	return Datum(datum.SAD69())
+}
+
+// GDA2020 provides a Datum similar to the Geocentric Datum of Australia 2020.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in Australia.
+func GDA2020() Datum {
+	return Datum(datum.GDA2020())
+}
+
+// Belge1972 provides a Datum similar to the Reseau National Belge 1972.
+//
+// It's based on the International1924 Spheroid.
+//
+// It is used in Belgium.
+func Belge1972() Datum {
+	return Datum(datum.Belge1972())
+}
+
+// NZGD2000 provides a Datum similar to the New Zealand Geodetic Datum 2000.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in New Zealand.
+func NZGD2000() Datum {
+	return Datum(datum.NZGD2000())
+}
+
+// NZGD49 provides a Datum similar to the New Zealand Geodetic Datum 1949.
+//
+// It's based on the International1924 Spheroid.
+//
+// It is used in New Zealand.
+func NZGD49() Datum {
+	return Datum(datum.NZGD49())
+}
+
+// IRENET95 provides a Datum similar to the IRENET95, the realization of
+// ETRS89 in Ireland.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in Ireland and Northern Ireland.
+func IRENET95() Datum {
+	return Datum(datum.IRENET95())
+}
+
+// SWEREF99 provides a Datum similar to the SWEREF99, the realization of
+// ETRS89 in Sweden.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in Sweden.
+func SWEREF99() Datum {
+	return Datum(datum.SWEREF99())
+}
+
+// Amersfoort provides a Datum similar to the Amersfoort.
+//
+// It's based on the Bessel Spheroid.
+//
+// It is used in the Netherlands.
+func Amersfoort() Datum {
+	return Datum(datum.Amersfoort())
+}
+
+// CH1903 provides a Datum similar to the CH1903.
+//
+// It's based on the Bessel Spheroid.
+//
+// It is used in Switzerland and Liechtenstein.
+func CH1903() Datum {
+	return Datum(datum.CH1903())
+}
+
+// CH1903Plus provides a Datum similar to the CH1903+.
+//
+// It's based on the Bessel Spheroid.
+//
+// It is used in Switzerland and Liechtenstein.
+func CH1903Plus() Datum {
+	return Datum(datum.CH1903Plus())
+}
+
+// JGD2000 provides a Datum similar to the Japanese Geodetic Datum 2000.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in Japan.
+func JGD2000() Datum {
+	return Datum(datum.JGD2000())
+}
+
+// JGD2011 provides a Datum similar to the Japanese Geodetic Datum 2011.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in Japan.
+func JGD2011() Datum {
+	return Datum(datum.JGD2011())
+}
+
+// SIRGAS2000 provides a Datum similar to the Sistema de Referencia Geocéntrico
+// para las Américas 2000.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in Latin America.
+func SIRGAS2000() Datum {
+	return Datum(datum.SIRGAS2000())
+}
+
+// NAD27 provides a Datum similar to the North American Datum 1927 in the
+// conterminous United States.
+//
+// It's based on the Clarke1866 Spheroid. The 3-parameter shift is only
+// accurate to about 10 meters, the NADCON grids to below 1 meter.
+//
+// It is used in the United States.
+func NAD27() Datum {
+	return Datum(datum.NAD27())
+}
+
+// NAD27Alaska provides a Datum similar to the North American Datum 1927 in
+// Alaska.
+//
+// It's based on the Clarke1866 Spheroid. The 3-parameter shift is only
+// accurate to about 10 meters, the NADCON grids to below 1 meter.
+//
+// It is used in Alaska.
+func NAD27Alaska() Datum {
+	return Datum(datum.NAD27Alaska())
+}
+
+// NAD27Canada provides a Datum similar to the North American Datum 1927 in
+// Canada.
+//
+// It's based on the Clarke1866 Spheroid. The 3-parameter shift is only
+// accurate to about 15 meters, the NTv2 grid of Natural Resources Canada to
+// below 1 meter.
+//
+// It is used in Canada.
+func NAD27Canada() Datum {
+	return Datum(datum.NAD27Canada())
+}
+
+// OldHawaiian provides a Datum similar to the Old Hawaiian.
+//
+// It's based on the Clarke1866 Spheroid.
+//
+// It is used in Hawaii.
+func OldHawaiian() Datum {
+	return Datum(datum.OldHawaiian())
+}
+
+// Pulkovo1942 provides a Datum similar to the Pulkovo 1942.
+//
+// It's based on the Krassowsky1940 Spheroid.
+//
+// It is used in Russia and other post-Soviet countries.
+func Pulkovo1942() Datum {
+	return Datum(datum.Pulkovo1942())
+}
+
+// CGCS2000 provides a Datum similar to the China Geodetic Coordinate System
+// 2000.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in China. Coordinates of Chinese web maps are usually GCJ-02,
+// which is obfuscated by a nonlinear offset of hundreds of meters. This
+// package intentionally doesn't implement it, a GCJ-02 library like one of the
+// go-gcj02 packages is needed to convert them to CGCS2000 or WGS84 first.
+func CGCS2000() Datum {
+	return Datum(datum.CGCS2000())
 }
 
 // Datum represents a Geodetic Datum like WGS84, ETRS89 or NAD83.
 //
 // It implements the Spheroid, Transformation and Area interface.
 //
-// By default it behaves like a WGS84 Datum.
+// By default it behaves like a WGS84 Datum. It can be converted from and to
+// the Datum of the datum package.
+//
+// Body is the celestial body of the Datum, like "Mars". It's empty for the
+// earth. Datums of different bodies can't be transformed into each other.
 type Datum struct {
 	Spheroid       Spheroid
 	Transformation Transformation
 	Area           Area
+	Body           string
 }
 
 // Contains method is the implementation of the Area interface.
@@ -177,51 +488,35 @@ type Datum struct {
 //
 // Returns true if nil.
 func (d Datum) Contains(lon, lat float64) bool {
-	return math.Abs(lon) <= 180 && math.Abs(lat) <= 90 && d.Area != nil && d.Area.Contains(lon, lat)
+	return datum.Datum(d).Contains(lon, lat)
 }
 
 // A returns the major axis of the implemented Spheroid.
 //
 // If nil it returns the major axis of the WGS84 Spheroid.
 func (d Datum) A() float64 {
-	if d.Spheroid == nil {
-		return A
-	}
-
-	return d.Spheroid.A()
+	return datum.Datum(d).A()
 }
 
 // Fi returns the inverse flattening of the implemented Spheroid.
 //
 // If nil it returns the inverse flattening of the WGS84 Spheroid.
 func (d Datum) Fi() float64 {
-	if d.Spheroid == nil {
-		return Fi
-	}
-
-	return d.Spheroid.Fi()
+	return datum.Datum(d).Fi()
 }
 
 // Forward transforms geocentric coordinates to WGS84.
 //
 // Returns x, y, z if nil.
 func (d Datum) Forward(x, y, z float64) (x0, y0, z0 float64) {
-	if d.Transformation == nil {
-		return x, y, z
-	}
-
-	return d.Transformation.Forward(x, y, z)
+	return datum.Datum(d).Forward(x, y, z)
 }
 
 // Inverse transforms geocentric coordinates from WGS84.
 //
 // Returns x0, y0, z0 if nil.
 func (d Datum) Inverse(x0, y0, z0 float64) (x, y, z float64) {
-	if d.Transformation == nil {
-		return x0, y0, z0
-	}
-
-	return d.Transformation.Inverse(x0, y0, z0)
+	return datum.Datum(d).Inverse(x0, y0, z0)
 }
 
 // XYZ is a geocentric Coordinate Reference System.
@@ -238,11 +533,56 @@ func (d Datum) LonLat() GeographicReferenceSystem {
 	}
 }
 
+// LonLat3D is a geographic Coordinate Reference System with ellipsoidal
+// heights.
+func (d Datum) LonLat3D() Geographic3DCRS {
+	return Geographic3DCRS{
+		GeographicReferenceSystem: d.LonLat(),
+	}
+}
+
+// LonLat2D is a geographic Coordinate Reference System ignoring heights.
+func (d Datum) LonLat2D() Geographic2DCRS {
+	return Geographic2DCRS{
+		GeographicReferenceSystem: d.LonLat(),
+	}
+}
+
 // WebMercator is a projected Coordinate Reference System.
 func (d Datum) WebMercator() ProjectedReferenceSystem {
 	return ProjectedReferenceSystem{
 		Datum:      d,
-		Projection: webMercator{},
+		Projection: proj.WebMercator{},
+	}
+}
+
+// Mercator is a projected Coordinate Reference System of the ellipsoidal
+// Mercator projection (variant A) with the central meridian lonf and the scale
+// on the equator. Unlike WebMercator it is conformal on the spheroid.
+func (d Datum) Mercator(lonf, scale, eastf, northf float64) ProjectedReferenceSystem {
+	return ProjectedReferenceSystem{
+		Datum: d,
+		Projection: proj.Mercator{
+			Lonf:   lonf,
+			Scale:  scale,
+			Eastf:  eastf,
+			Northf: northf,
+		},
+	}
+}
+
+// Equirectangular is a projected Coordinate Reference System of the
+// Equidistant Cylindrical projection with the central meridian lonf and the
+// standard parallel latts. Longitudes and latitudes are scaled linearly.
+func (d Datum) Equirectangular(lonf, latts, eastf, northf float64) ProjectedReferenceSystem {
+	return ProjectedReferenceSystem{
+		Datum: d,
+		Projection: proj.Equirectangular{
+			Lonf:   lonf,
+			Latts:  latts,
+			Eastf:  eastf,
+			Northf: northf,
+		},
 	}
 }
 
@@ -250,12 +590,12 @@ func (d Datum) WebMercator() ProjectedReferenceSystem {
 func (d Datum) TransverseMercator(lonf, latf, scale, eastf, northf float64) ProjectedReferenceSystem {
 	return ProjectedReferenceSystem{
 		Datum: d,
-		Projection: transverseMercator{
-			lonf:   lonf,
-			latf:   latf,
-			scale:  scale,
-			eastf:  eastf,
-			northf: northf,
+		Projection: proj.TransverseMercator{
+			Lonf:   lonf,
+			Latf:   latf,
+			Scale:  scale,
+			Eastf:  eastf,
+			Northf: northf,
 		},
 	}
 }
@@ -264,13 +604,29 @@ func (d Datum) TransverseMercator(lonf, latf, scale, eastf, northf float64) Proj
 func (d Datum) LambertConformalConic2SP(lonf, latf, lat1, lat2, eastf, northf float64) ProjectedReferenceSystem {
 	return ProjectedReferenceSystem{
 		Datum: d,
-		Projection: lambertConformalConic2SP{
-			lonf:   lonf,
-			latf:   latf,
-			lat1:   lat1,
-			lat2:   lat2,
-			eastf:  eastf,
-			northf: northf,
+		Projection: proj.LambertConformalConic2SP{
+			Lonf:   lonf,
+			Latf:   latf,
+			Lat1:   lat1,
+			Lat2:   lat2,
+			Eastf:  eastf,
+			Northf: northf,
+		},
+	}
+}
+
+// LambertConformalConic1SP is a projected Coordinate Reference System of the
+// Lambert Conformal Conic projection with the standard parallel latf and the
+// scale factor scale on it.
+func (d Datum) LambertConformalConic1SP(lonf, latf, scale, eastf, northf float64) ProjectedReferenceSystem {
+	return ProjectedReferenceSystem{
+		Datum: d,
+		Projection: proj.LambertConformalConic1SP{
+			Lonf:   lonf,
+			Latf:   latf,
+			Scale:  scale,
+			Eastf:  eastf,
+			Northf: northf,
 		},
 	}
 }
@@ -279,25 +635,219 @@ func (d Datum) LambertConformalConic2SP(lonf, latf, lat1, lat2, eastf, northf fl
 func (d Datum) AlbersEqualAreaConic(lonf, latf, lat1, lat2, eastf, northf float64) ProjectedReferenceSystem {
 	return ProjectedReferenceSystem{
 		Datum: d,
-		Projection: albersEqualAreaConic{
-			lonf:   lonf,
-			latf:   latf,
-			lat1:   lat1,
-			lat2:   lat2,
-			eastf:  eastf,
-			northf: northf,
+		Projection: proj.AlbersEqualAreaConic{
+			Lonf:   lonf,
+			Latf:   latf,
+			Lat1:   lat1,
+			Lat2:   lat2,
+			Eastf:  eastf,
+			Northf: northf,
 		},
 	}
 }
 
+// LambertAzimuthalEqualArea is a projected Coordinate Reference System with
+// the natural origin at lonf and latf. The aspect is polar if latf is 90 or
+// -90 and oblique otherwise.
 func (d Datum) LambertAzimuthalEqualArea(lonf, latf, eastf, northf float64) ProjectedReferenceSystem {
 	return ProjectedReferenceSystem{
 		Datum: d,
-		Projection: lambertAzimuthalEqualArea{
-			latf:   latf,
-			lonf:   lonf,
-			eastf:  eastf,
-			northf: northf,
+		Projection: proj.LambertAzimuthalEqualArea{
+			Latf:   latf,
+			Lonf:   lonf,
+			Eastf:  eastf,
+			Northf: northf,
+		},
+	}
+}
+
+// HotineObliqueMercator is a projected Coordinate Reference System with the
+// projection centre at lonc and latc, the azimuth of the initial line and the
+// angle gamma from the rectified to the skew grid in degrees. The false
+// easting and northing are at the projection centre (variant B).
+func (d Datum) HotineObliqueMercator(lonc, latc, azimuth, gamma, scale, eastf, northf float64) ProjectedReferenceSystem {
+	return ProjectedReferenceSystem{
+		Datum: d,
+		Projection: proj.HotineObliqueMercator{
+			Lonc:    lonc,
+			Latc:    latc,
+			Azimuth: azimuth,
+			Gamma:   gamma,
+			Scale:   scale,
+			Eastf:   eastf,
+			Northf:  northf,
+		},
+	}
+}
+
+// HotineObliqueMercatorA is like HotineObliqueMercator, but the false easting
+// and northing are at the natural origin of the initial line (variant A).
+func (d Datum) HotineObliqueMercatorA(lonc, latc, azimuth, gamma, scale, eastf, northf float64) ProjectedReferenceSystem {
+	return ProjectedReferenceSystem{
+		Datum: d,
+		Projection: proj.HotineObliqueMercator{
+			Lonc:          lonc,
+			Latc:          latc,
+			Azimuth:       azimuth,
+			Gamma:         gamma,
+			Scale:         scale,
+			Eastf:         eastf,
+			Northf:        northf,
+			NaturalOrigin: true,
+		},
+	}
+}
+
+// Stereographic is a projected Coordinate Reference System with the natural
+// origin at lonf and latf. It is the Polar Stereographic projection (variant
+// A) at the poles and the Oblique Stereographic projection otherwise.
+func (d Datum) Stereographic(lonf, latf, scale, eastf, northf float64) ProjectedReferenceSystem {
+	if math.Abs(latf) == 90 {
+		return d.PolarStereographic(lonf, latf, scale, eastf, northf)
+	}
+
+	return ProjectedReferenceSystem{
+		Datum: d,
+		Projection: proj.ObliqueStereographic{
+			Lonf:   lonf,
+			Latf:   latf,
+			Scale:  scale,
+			Eastf:  eastf,
+			Northf: northf,
+		},
+	}
+}
+
+// PolarStereographic is a projected Coordinate Reference System with the
+// natural origin at the pole of the latitude latf, which is 90 or -90.
+func (d Datum) PolarStereographic(lonf, latf, scale, eastf, northf float64) ProjectedReferenceSystem {
+	return ProjectedReferenceSystem{
+		Datum: d,
+		Projection: proj.PolarStereographic{
+			Lonf:   lonf,
+			Latf:   latf,
+			Scale:  scale,
+			Eastf:  eastf,
+			Northf: northf,
+		},
+	}
+}
+
+// CassiniSoldner is a projected Coordinate Reference System of the
+// Cassini-Soldner projection with the natural origin at lonf and latf.
+func (d Datum) CassiniSoldner(lonf, latf, eastf, northf float64) ProjectedReferenceSystem {
+	return ProjectedReferenceSystem{
+		Datum: d,
+		Projection: proj.CassiniSoldner{
+			Lonf:   lonf,
+			Latf:   latf,
+			Eastf:  eastf,
+			Northf: northf,
+		},
+	}
+}
+
+// Polyconic is a projected Coordinate Reference System of the American
+// Polyconic projection with the natural origin at lonf and latf.
+func (d Datum) Polyconic(lonf, latf, eastf, northf float64) ProjectedReferenceSystem {
+	return ProjectedReferenceSystem{
+		Datum: d,
+		Projection: proj.Polyconic{
+			Lonf:   lonf,
+			Latf:   latf,
+			Eastf:  eastf,
+			Northf: northf,
+		},
+	}
+}
+
+// NewZealandMapGrid is a projected Coordinate Reference System of the New
+// Zealand Map Grid projection with the natural origin at lonf and latf.
+//
+// The projection is only accurate in New Zealand on the International1924
+// Spheroid.
+func (d Datum) NewZealandMapGrid(lonf, latf, eastf, northf float64) ProjectedReferenceSystem {
+	return ProjectedReferenceSystem{
+		Datum: d,
+		Projection: proj.NewZealandMapGrid{
+			Lonf:   lonf,
+			Latf:   latf,
+			Eastf:  eastf,
+			Northf: northf,
+		},
+	}
+}
+
+// Orthographic is a projected Coordinate Reference System of the Orthographic
+// projection with the natural origin at lonf and latf, like a view of the
+// globe from space.
+//
+// Its Area is the visible hemisphere, so SafeTransform returns
+// ErrOutOfBounds for points behind it, while Transform returns NaN.
+func (d Datum) Orthographic(lonf, latf float64) ProjectedReferenceSystem {
+	p := proj.Orthographic{
+		Lonf: lonf,
+		Latf: latf,
+	}
+
+	return ProjectedReferenceSystem{
+		Datum:      d,
+		Projection: p,
+		Area:       AreaFunc(p.Visible),
+	}
+}
+
+// AzimuthalEquidistant is a projected Coordinate Reference System of the
+// Azimuthal Equidistant projection with the natural origin at lonf and latf.
+//
+// Its Area is the hemisphere around the natural origin, where the geodesics
+// are solved reliably.
+func (d Datum) AzimuthalEquidistant(lonf, latf, eastf, northf float64) ProjectedReferenceSystem {
+	p := proj.AzimuthalEquidistant{
+		Lonf:   lonf,
+		Latf:   latf,
+		Eastf:  eastf,
+		Northf: northf,
+	}
+
+	return ProjectedReferenceSystem{
+		Datum:      d,
+		Projection: p,
+		Area:       AreaFunc(p.Hemisphere),
+	}
+}
+
+// GuamProjection is a projected Coordinate Reference System of the Guam
+// Projection with the natural origin at lonf and latf, an approximation of
+// the Azimuthal Equidistant projection that is only accurate on Guam.
+func (d Datum) GuamProjection(lonf, latf, eastf, northf float64) ProjectedReferenceSystem {
+	return ProjectedReferenceSystem{
+		Datum: d,
+		Projection: proj.AzimuthalEquidistant{
+			Lonf:   lonf,
+			Latf:   latf,
+			Eastf:  eastf,
+			Northf: northf,
+			Guam:   true,
+		},
+	}
+}
+
+// Krovak is a projected Coordinate Reference System of the Krovak oblique
+// conic conformal projection with the projection centre at lonc and latc, the
+// azimuth of the cone axis and the pseudo standard parallel in degrees. The
+// coordinates are east and north (Krovak East North).
+func (d Datum) Krovak(lonc, latc, azimuth, pseudoStandardParallel, scale, eastf, northf float64) ProjectedReferenceSystem {
+	return ProjectedReferenceSystem{
+		Datum: d,
+		Projection: proj.Krovak{
+			Lonc:    lonc,
+			Latc:    latc,
+			Azimuth: azimuth,
+			Latp:    pseudoStandardParallel,
+			Scale:   scale,
+			Eastf:   eastf,
+			Northf:  northf,
 		},
 	}
 }
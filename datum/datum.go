@@ -0,0 +1,117 @@
+// Package datum provides geodetic datums and the Helmert transformation.
+//
+// A Datum combines a Spheroid, the Transformation of its geocentric
+// coordinates to WGS84 and the Area of its use. It can be converted to a
+// wgs84.Datum to build Coordinate Reference Systems:
+//
+//	wgs84.Datum(datum.ETRS89()).TransverseMercator(9, 0, 0.9996, 500000, 0)
+package datum
+
+import "math"
+
+// Spheroid interface represents an ellipsoid of revolution used by
+// geodetic datums.
+//
+// It is specified by the major axis and the inverse flattening.
+type Spheroid interface {
+	A() float64
+	Fi() float64
+}
+
+// Transformation interface represents the transformation of geocentric
+// coordinates To and From WGS84.
+//
+// The Forward method is used to transform coordinates to the WGS84 System.
+type Transformation interface {
+	Forward(x, y, z float64) (x0, y0, z0 float64)
+	Inverse(x0, y0, z0 float64) (x, y, z float64)
+}
+
+// Area interface is used to describe the Bounding Box of a Datum.
+//
+// It is implemented by the AreaFunc.
+type Area interface {
+	Contains(lon, lat float64) bool
+}
+
+// AreaFunc implements the Contains method of the Area interface.
+//
+// Returns true if nil.
+//
+// Returns false for latitudes with an absolute over 90 and longitudes over 180.
+type AreaFunc func(lon, lat float64) bool
+
+// Contains method is the implementation of the Area interface.
+//
+// Returns false for latitudes with an absolute over 90 and longitudes over 180.
+func (a AreaFunc) Contains(lon, lat float64) bool {
+	return math.Abs(lat) <= 180 && math.Abs(lat) <= 90 && (a == nil || a(lon, lat))
+}
+
+// Datum represents a Geodetic Datum like WGS84, ETRS89 or NAD83.
+//
+// It implements the Spheroid, Transformation and Area interface.
+//
+// By default it behaves like a WGS84 Datum.
+//
+// Body is the celestial body of the Datum, like "Mars". It's empty for the
+// earth. Datums of different bodies can't be transformed into each other.
+type Datum struct {
+	Spheroid       Spheroid
+	Transformation Transformation
+	Area           Area
+	Body           string
+}
+
+// Contains method is the implementation of the Area interface.
+//
+// Returns false for latitudes with an absolute over 90 and longitudes over 180.
+//
+// Returns true if nil.
+func (d Datum) Contains(lon, lat float64) bool {
+	return math.Abs(lon) <= 180 && math.Abs(lat) <= 90 && d.Area != nil && d.Area.Contains(lon, lat)
+}
+
+// A returns the major axis of the implemented Spheroid.
+//
+// If nil it returns the major axis of the WGS84 Spheroid.
+func (d Datum) A() float64 {
+	if d.Spheroid == nil {
+		return WGS84Ellipsoid{}.A()
+	}
+
+	return d.Spheroid.A()
+}
+
+// Fi returns the inverse flattening of the implemented Spheroid.
+//
+// If nil it returns the inverse flattening of the WGS84 Spheroid.
+func (d Datum) Fi() float64 {
+	if d.Spheroid == nil {
+		return WGS84Ellipsoid{}.Fi()
+	}
+
+	return d.Spheroid.Fi()
+}
+
+// Forward transforms geocentric coordinates to WGS84.
+//
+// Returns x, y, z if nil.
+func (d Datum) Forward(x, y, z float64) (x0, y0, z0 float64) {
+	if d.Transformation == nil {
+		return x, y, z
+	}
+
+	return d.Transformation.Forward(x, y, z)
+}
+
+// Inverse transforms geocentric coordinates from WGS84.
+//
+// Returns x0, y0, z0 if nil.
+func (d Datum) Inverse(x0, y0, z0 float64) (x, y, z float64) {
+	if d.Transformation == nil {
+		return x0, y0, z0
+	}
+
+	return d.Transformation.Inverse(x0, y0, z0)
+}
@@ -0,0 +1,96 @@
+package datum
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrInvalidGrid is returned by the grid readers for malformed grid files.
+var ErrInvalidGrid = errors.New("invalid grid")
+
+// Grid interface represents a grid of horizontal datum shifts.
+//
+// Shift returns the bilinear-interpolated shifts in degrees that are added to
+// the longitude and latitude in degrees. It returns false outside the grid.
+type Grid interface {
+	Shift(lon, lat float64) (dlon, dlat float64, ok bool)
+}
+
+// GridShift is a Transformation that shifts geographic coordinates on the
+// Spheroid From by a Grid to the Spheroid To of a datum that is taken as
+// WGS84, like ETRS89.
+//
+// Outside the Grid the Fallback is used, usually the Helmert of the source
+// datum. The ellipsoidal height is not changed by the Grid.
+type GridShift struct {
+	Grid     Grid
+	From, To Spheroid
+	Fallback Transformation
+}
+
+// Forward transforms geocentric coordinates to WGS84.
+func (t GridShift) Forward(x, y, z float64) (x0, y0, z0 float64) {
+	lon, lat, h := toLonLat(x, y, z, t.From)
+
+	dlon, dlat, ok := t.Grid.Shift(lon, lat)
+	if !ok {
+		return t.fallback().Forward(x, y, z)
+	}
+
+	return toXYZ(lon+dlon, lat+dlat, h, t.To)
+}
+
+// Inverse transforms geocentric coordinates from WGS84.
+//
+// The shifts at the source coordinates are found by fixed-point iteration.
+func (t GridShift) Inverse(x0, y0, z0 float64) (x, y, z float64) {
+	lon0, lat0, h := toLonLat(x0, y0, z0, t.To)
+	lon, lat := lon0, lat0
+
+	for i := 0; i < 10; i++ {
+		dlon, dlat, ok := t.Grid.Shift(lon, lat)
+		if !ok {
+			return t.fallback().Inverse(x0, y0, z0)
+		}
+
+		next, nlat := lon0-dlon, lat0-dlat
+		done := math.Abs(next-lon) < 1e-12 && math.Abs(nlat-lat) < 1e-12
+		lon, lat = next, nlat
+
+		if done {
+			break
+		}
+	}
+
+	return toXYZ(lon, lat, h, t.From)
+}
+
+func (t GridShift) fallback() Transformation {
+	if t.Fallback == nil {
+		return Helmert{}
+	}
+
+	return t.Fallback
+}
+
+func toXYZ(lon, lat, h float64, s Spheroid) (x, y, z float64) {
+	φ, λ := lat*math.Pi/180, lon*math.Pi/180
+	e2 := (2 - 1/s.Fi()) / s.Fi()
+	n := s.A() / math.Sqrt(1-e2*math.Sin(φ)*math.Sin(φ))
+
+	return (n + h) * math.Cos(φ) * math.Cos(λ),
+		(n + h) * math.Cos(φ) * math.Sin(λ),
+		(n*(1-e2) + h) * math.Sin(φ)
+}
+
+func toLonLat(x, y, z float64, s Spheroid) (lon, lat, h float64) {
+	a := s.A()
+	b := a * (1 - 1/s.Fi())
+	e2 := (2 - 1/s.Fi()) / s.Fi()
+	p := math.Hypot(x, y)
+	θ := math.Atan2(z*a, p*b)
+	φ := math.Atan2(z+e2*a*a/b*math.Pow(math.Sin(θ), 3), p-e2*a*math.Pow(math.Cos(θ), 3))
+	n := a / math.Sqrt(1-e2*math.Sin(φ)*math.Sin(φ))
+
+	return math.Atan2(y, x) * 180 / math.Pi, φ * 180 / math.Pi, p/math.Cos(φ) - n
+}
@@ -0,0 +1,73 @@
+package datum
+
+import "math"
+
+const (
+	asec = math.Pi / 648000
+	ppm  = 0.000001
+)
+
+// Helmert is a 7-parameter-Helmert-Transformation to WGS84 in the position
+// vector convention.
+//
+// Tx, Ty and Tz are the translations in meters, Rx, Ry and Rz the rotations
+// in arc seconds and Ds the scale difference in parts per million.
+type Helmert struct {
+	Tx, Ty, Tz, Rx, Ry, Rz, Ds float64
+}
+
+// Forward transforms geocentric coordinates to WGS84.
+func (t Helmert) Forward(x, y, z float64) (x0, y0, z0 float64) {
+	return calcHelmert(x, y, z, t.Tx, t.Ty, t.Tz, t.Rx, t.Ry, t.Rz, t.Ds)
+}
+
+// Inverse transforms geocentric coordinates from WGS84.
+func (t Helmert) Inverse(x0, y0, z0 float64) (x, y, z float64) {
+	return calcHelmert(x0, y0, z0, -t.Tx, -t.Ty, -t.Tz, -t.Rx, -t.Ry, -t.Rz, -t.Ds)
+}
+
+func calcHelmert(x, y, z, tx, ty, tz, rx, ry, rz, ds float64) (x0, y0, z0 float64) {
+	x0 = (1+ds*ppm)*(x+z*ry*asec-y*rz*asec) + tx
+	y0 = (1+ds*ppm)*(y+x*rz*asec-z*rx*asec) + ty
+	z0 = (1+ds*ppm)*(z+y*rx*asec-x*ry*asec) + tz
+
+	return
+}
+
+// TimeDependentHelmert is a 14-parameter-Helmert-Transformation to WGS84 in
+// the position vector convention.
+//
+// The parameters of the Helmert are valid at the RefEpoch and change by the
+// Rates per year. The transformation is evaluated at the Epoch of the
+// coordinates in decimal years.
+type TimeDependentHelmert struct {
+	Helmert
+	Rates    Helmert
+	RefEpoch float64
+	Epoch    float64
+}
+
+// Forward transforms geocentric coordinates to WGS84.
+func (t TimeDependentHelmert) Forward(x, y, z float64) (x0, y0, z0 float64) {
+	return t.At().Forward(x, y, z)
+}
+
+// Inverse transforms geocentric coordinates from WGS84.
+func (t TimeDependentHelmert) Inverse(x0, y0, z0 float64) (x, y, z float64) {
+	return t.At().Inverse(x0, y0, z0)
+}
+
+// At returns the Helmert evaluated at the Epoch.
+func (t TimeDependentHelmert) At() Helmert {
+	dt := t.Epoch - t.RefEpoch
+
+	return Helmert{
+		Tx: t.Tx + t.Rates.Tx*dt,
+		Ty: t.Ty + t.Rates.Ty*dt,
+		Tz: t.Tz + t.Rates.Tz*dt,
+		Rx: t.Rx + t.Rates.Rx*dt,
+		Ry: t.Ry + t.Rates.Ry*dt,
+		Rz: t.Rz + t.Rates.Rz*dt,
+		Ds: t.Ds + t.Rates.Ds*dt,
+	}
+}
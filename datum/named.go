@@ -0,0 +1,961 @@
+package datum
+
+import "math"
+
+// WGS84 provides a Datum similar to the World Geodetic System 1984.
+//
+// It's based on the WGS84 Spheroid.
+//
+// It is used worldwide.
+func WGS84() Datum {
+	return Datum{
+		Spheroid: WGS84Ellipsoid{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return math.Abs(lon) <= 180 && math.Abs(lat) <= 90
+		}),
+	}
+}
+
+// ETRS89 provides a Datum similar to the European Terrestrial Reference
+// System 1989.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in Europe.
+func ETRS89() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -16.1 && lon <= 40.18 && lat >= 32.88 && lat <= 84.17
+		}),
+	}
+}
+
+// OSGB36 provides a Datum similar to the Ordnance Survey Great Britain 1936.
+//
+// It's based on the Airy Spheroid and a 7-parameter-Helmert-Transformation
+// with the parameters: 446.448,-125.157,542.06,0.15,0.247,0.842,-20.489.
+//
+// https://epsg.io/1314
+//
+// It is used in Great Britain.
+func OSGB36() Datum {
+	return Datum{
+		Spheroid: Airy{},
+		Transformation: Helmert{
+			Tx: 446.448,
+			Ty: -125.157,
+			Tz: 542.06,
+			Rx: 0.15,
+			Ry: 0.247,
+			Rz: 0.842,
+			Ds: -20.489,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -8.82 && lon <= 1.92 && lat >= 49.79 && lat <= 60.94
+		}),
+	}
+}
+
+// MGI provides a Datum similar to the Militar-Geographische Institut.
+//
+// It's based on the Bessel Spheroid and a 7-parameter-Helmert-Transformation
+// with the parameters: 577.326,90.129,463.919,5.137,1.474,5.297,2.4232.
+//
+// https://epsg.io/1618
+//
+// It is used in Austria.
+func MGI() Datum {
+	return Datum{
+		Spheroid: Bessel{},
+		Transformation: Helmert{
+			Tx: 577.326,
+			Ty: 90.129,
+			Tz: 463.919,
+			Rx: 5.137,
+			Ry: 1.474,
+			Rz: 5.297,
+			Ds: 2.4232,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 9.53 && lon <= 17.17 && lat >= 46.4 && lat <= 49.02
+		}),
+	}
+}
+
+// DHDN2001 provides a Datum similar to the Deutsches Hauptdreiecksnetz 2001.
+//
+// It's based on the Bessel Spheroid and a 7-parameter-Helmert-Transformation
+// with the parameters: 598.1, 73.7, 418.2, 0.202, 0.045, -2.455, 6.7.
+//
+// https://epsg.io/1776
+//
+// It is used in Germay.
+func DHDN2001() Datum {
+	return Datum{
+		Spheroid: Bessel{},
+		Transformation: Helmert{
+			Tx: 598.1,
+			Ty: 73.7,
+			Tz: 418.2,
+			Rx: 0.202,
+			Ry: 0.045,
+			Rz: -2.455,
+			Ds: 6.7,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 5.87 && lon <= 13.84 && lat >= 47.27 && lat <= 55.09
+		}),
+	}
+}
+
+// RGF93 provides a Datum similar to the Réseau géodésique français 1993.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in France.
+func RGF93() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -9.86 && lon <= 10.38 && lat >= 41.15 && lat <= 51.56
+		}),
+	}
+}
+
+// NAD83 provides a Datum similar to the North American Datum 1983.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in North-America.
+func NAD83() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -172.54 && lon <= -47.74 && lat >= 23.81 && lat <= 86.46
+		}),
+	}
+}
+
+// SIRGAS95 provides a Datum similar to the Sistema de Referencia Geocéntrico
+// para las Américas 1995, the precursor of SIRGAS2000.
+//
+// It's based on the GRS80 Spheroid and a 7-parameter-Helmert-Transformation
+// from its realization of ITRF94 with the parameters:
+// -0.0048,-0.0026,0.0332,0,0,-0.00006,-0.00292.
+//
+// https://epsg.io/4170
+//
+// It is used in South America.
+func SIRGAS95() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Transformation: Helmert{
+			Tx: -0.0048,
+			Ty: -0.0026,
+			Tz: 0.0332,
+			Rz: -0.00006,
+			Ds: -0.00292,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -113.21 && lon <= -25.99 && lat >= -59.87 && lat <= 16.75
+		}),
+	}
+}
+
+// AFREF provides a Datum similar to the African Geodetic Reference Frame.
+//
+// It's based on the GRS80 Spheroid and realized in ITRF2005, which is
+// compatible with WGS84 at the centimeter level.
+//
+// It is used in Africa.
+func AFREF() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -25.51 && lon <= 63.51 && lat >= -47.13 && lat <= 37.92
+		}),
+	}
+}
+
+// RGAF09 provides a Datum similar to the Réseau Géodésique des Antilles
+// Françaises 2009.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/5489
+//
+// It is used in the French Antilles.
+func RGAF09() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -63.66 && lon <= -57.52 && lat >= 14.08 && lat <= 18.54
+		}),
+	}
+}
+
+// JAD2001 provides a Datum similar to the Jamaica 2001.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/4758
+//
+// It is used in Jamaica.
+func JAD2001() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -80.59 && lon <= -74.94 && lat >= 14.08 && lat <= 19.36
+		}),
+	}
+}
+
+// CIGD11 provides a Datum similar to the Cayman Islands Geodetic Datum 2011.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/6135
+//
+// It is used in the Cayman Islands.
+func CIGD11() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -83.6 && lon <= -78.72 && lat >= 17.58 && lat <= 20.68
+		}),
+	}
+}
+
+// NAD83CORS96 provides a Datum similar to the North American Datum 1983
+// realized by the Continuously Operating Reference Stations in 1996.
+//
+// It's based on the GRS80 Spheroid and the 14-parameter-Helmert-Transformation
+// of the National Geodetic Survey from ITRF2000 at the reference epoch 1997.0,
+// evaluated at the epoch of the coordinates in decimal years, for example
+// 2002.0 for the realization.
+//
+// https://epsg.io/6783
+//
+// It is used in North-America.
+func NAD83CORS96(epoch float64) Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Transformation: TimeDependentHelmert{
+			Helmert: Helmert{
+				Tx: -0.9956,
+				Ty: 1.9013,
+				Tz: 0.5215,
+				Rx: 0.025915,
+				Ry: 0.009426,
+				Rz: 0.011599,
+				Ds: -0.00062,
+			},
+			Rates: Helmert{
+				Tx: -0.0007,
+				Ty: 0.0007,
+				Tz: -0.0005,
+				Rx: 0.000067,
+				Ry: -0.000757,
+				Rz: -0.000051,
+				Ds: 0.00018,
+			},
+			RefEpoch: 1997,
+			Epoch:    epoch,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -172.54 && lon <= -47.74 && lat >= 23.81 && lat <= 86.46
+		}),
+	}
+}
+
+// MexicoITRF2008 provides a Datum similar to the Mexico ITRF2008.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/6365
+//
+// It is used in Mexico.
+func MexicoITRF2008() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -122.19 && lon <= -84.64 && lat >= 12.1 && lat <= 32.72
+		}),
+	}
+}
+
+// POSGAR07 provides a Datum similar to the Posiciones Geodésicas Argentinas
+// 2007.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/5340
+//
+// It is used in Argentina.
+func POSGAR07() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -73.59 && lon <= -53.65 && lat >= -58.41 && lat <= -21.78
+		}),
+	}
+}
+
+// PSAD56 provides a Datum similar to the Provisional South American Datum
+// 1956.
+//
+// It's based on the International1924 Spheroid and a 3-parameter-Helmert-
+// Transformation with the parameters for Peru: -279,175,-379.
+//
+// https://epsg.io/1209
+//
+// It is used in South America.
+func PSAD56() Datum {
+	return Datum{
+		Spheroid: International1924{},
+		Transformation: Helmert{
+			Tx: -279,
+			Ty: 175,
+			Tz: -379,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -81.41 && lon <= -47.99 && lat >= -43.5 && lat <= 12.52
+		}),
+	}
+}
+
+// MAGNA provides a Datum similar to the Marco Geocéntrico Nacional de
+// Referencia, the densification of SIRGAS in Colombia.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/4686
+//
+// It is used in Colombia.
+func MAGNA() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -84.77 && lon <= -66.87 && lat >= -4.23 && lat <= 15.51
+		}),
+	}
+}
+
+// SSITDEF provides a Datum similar to the Sistema de Referencia Geocéntrico
+// de Chile 2009, which is aligned to ITRF2005.
+//
+// It's based on the GRS80 Spheroid.
+//
+// It is used in mainland Chile and the Chilean Antarctic Territory.
+func SSITDEF() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			if lon >= -90 && lon <= -53 && lat <= -60 {
+				return true
+			}
+
+			return lon >= -75.81 && lon <= -66.41 && lat >= -56.01 && lat <= -17.49
+		}),
+	}
+}
+
+// REGVEN provides a Datum similar to the Red Geocéntrica Venezolana.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/4189
+//
+// It is used in Venezuela.
+func REGVEN() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -73.38 && lon <= -58.95 && lat >= 0.64 && lat <= 16.75
+		}),
+	}
+}
+
+// GDM2000 provides a Datum similar to the Geodetic Datum of Malaysia 2000.
+//
+// It's based on the GRS80 Spheroid and realized in ITRF2000.
+//
+// https://epsg.io/4742
+//
+// It is used in Malaysia.
+func GDM2000() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 98.02 && lon <= 119.61 && lat >= 0.85 && lat <= 7.81
+		}),
+	}
+}
+
+// SJTSK provides a Datum similar to the System of the Unified Trigonometrical
+// Cadastral Network.
+//
+// It's based on the Bessel Spheroid and a 7-parameter-Helmert-Transformation
+// with the parameters: 570.8,85.7,462.8,4.998,1.587,5.261,3.56.
+//
+// https://epsg.io/4156
+//
+// It is used in the Czech Republic and Slovakia.
+func SJTSK() Datum {
+	return Datum{
+		Spheroid: Bessel{},
+		Transformation: Helmert{
+			Tx: 570.8,
+			Ty: 85.7,
+			Tz: 462.8,
+			Rx: 4.998,
+			Ry: 1.587,
+			Rz: 5.261,
+			Ds: 3.56,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 12.09 && lon <= 22.56 && lat >= 47.73 && lat <= 51.06
+		}),
+	}
+}
+
+// Trinidad1903 provides a Datum similar to the Trinidad 1903.
+//
+// It's based on the Clarke1858 Spheroid and a 3-parameter-Helmert-
+// Transformation with the parameters: -61.702,284.488,472.052.
+//
+// https://epsg.io/4302
+//
+// It is used in Trinidad and Tobago.
+func Trinidad1903() Datum {
+	return Datum{
+		Spheroid: Clarke1858{},
+		Transformation: Helmert{
+			Tx: -61.702,
+			Ty: 284.488,
+			Tz: 472.052,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -62.09 && lon <= -60.44 && lat >= 9.83 && lat <= 11.51
+		}),
+	}
+}
+
+// Ireland1965 provides a Datum similar to the Geodetic Datum of 1965 (TM75).
+//
+// It's based on the AiryModified Spheroid and a 7-parameter-Helmert-
+// Transformation with the parameters: 482.5,-130.6,564.6,-1.042,-0.214,
+// -0.631,8.15.
+//
+// https://epsg.io/4300
+//
+// It is used in Ireland and Northern Ireland.
+func Ireland1965() Datum {
+	return Datum{
+		Spheroid: AiryModified{},
+		Transformation: Helmert{
+			Tx: 482.5,
+			Ty: -130.6,
+			Tz: 564.6,
+			Rx: -1.042,
+			Ry: -0.214,
+			Rz: -0.631,
+			Ds: 8.15,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -10.56 && lon <= -5.34 && lat >= 51.39 && lat <= 55.43
+		}),
+	}
+}
+
+// NTF provides a Datum similar to the Nouvelle Triangulation Française.
+//
+// It's based on the Clarke1880IGN Spheroid and a 3-parameter-Helmert-
+// Transformation with the parameters: -168,-60,320.
+//
+// https://epsg.io/4275
+//
+// It is used in France.
+func NTF() Datum {
+	return Datum{
+		Spheroid: Clarke1880IGN{},
+		Transformation: Helmert{
+			Tx: -168,
+			Ty: -60,
+			Tz: 320,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -4.87 && lon <= 9.63 && lat >= 41.31 && lat <= 51.14
+		}),
+	}
+}
+
+// ED50 provides a Datum similar to the European Datum 1950.
+//
+// It's based on the International1924 Spheroid and a 7-parameter-Helmert-
+// Transformation with the parameters: -89.5,-93.8,-123.1,0,0,-0.156,1.2.
+//
+// https://epsg.io/4230
+//
+// It is used in Europe.
+func ED50() Datum {
+	return Datum{
+		Spheroid: International1924{},
+		Transformation: Helmert{
+			Tx: -89.5,
+			Ty: -93.8,
+			Tz: -123.1,
+			Rz: -0.156,
+			Ds: 1.2,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -16.1 && lon <= 48.61 && lat >= 25.71 && lat <= 84.73
+		}),
+	}
+}
+
+// Guam1963 provides a Datum similar to the Guam 1963.
+//
+// It's based on the Clarke1866 Spheroid and a 3-parameter-Helmert-
+// Transformation with the parameters: -100,-248,259.
+//
+// https://epsg.io/4675
+//
+// It is used in Guam.
+func Guam1963() Datum {
+	return Datum{
+		Spheroid: Clarke1866{},
+		Transformation: Helmert{
+			Tx: -100,
+			Ty: -248,
+			Tz: 259,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 144.58 && lon <= 145.01 && lat >= 13.18 && lat <= 13.7
+		}),
+	}
+}
+
+// MonteMario provides a Datum similar to the Monte Mario (Roma40).
+//
+// It's based on the International1924 Spheroid and a 7-parameter-Helmert-
+// Transformation with the parameters: -104.1,-49.1,-9.9,0.971,-2.917,0.714,-11.68.
+//
+// https://epsg.io/4265
+//
+// It is used in Italy.
+func MonteMario() Datum {
+	return Datum{
+		Spheroid: International1924{},
+		Transformation: Helmert{
+			Tx: -104.1,
+			Ty: -49.1,
+			Tz: -9.9,
+			Rx: 0.971,
+			Ry: -2.917,
+			Rz: 0.714,
+			Ds: -11.68,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 6.62 && lon <= 18.58 && lat >= 35.48 && lat <= 47.1
+		}),
+	}
+}
+
+// SAD69 provides a Datum similar to the South American Datum 1969.
+//
+// It's based on the GRS67Modified Spheroid and a 3-parameter-Helmert-
+// Transformation with the parameters: -57,1,-41.
+//
+// https://epsg.io/4618
+//
+// It is used in South America.
+func SAD69() Datum {
+	return Datum{
+		Spheroid: GRS67Modified{},
+		Transformation: Helmert{
+			Tx: -57,
+			Ty: 1,
+			Tz: -41,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -91.72 && lon <= -25.28 && lat >= -55.96 && lat <= 12.52
+		}),
+	}
+}
+
+// GDA2020 provides a Datum similar to the Geocentric Datum of Australia 2020.
+//
+// It's based on the GRS80 Spheroid and realized in ITRF2014.
+//
+// https://epsg.io/7844
+//
+// It is used in Australia.
+func GDA2020() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 93.41 && lon <= 173.35 && lat >= -60.55 && lat <= -8.47
+		}),
+	}
+}
+
+// Belge1972 provides a Datum similar to the Reseau National Belge 1972.
+//
+// It's based on the International1924 Spheroid and a 7-parameter-Helmert-
+// Transformation with the parameters: -106.8686,52.2978,-103.7239,0.3366,-0.457,1.8422,-1.2747.
+//
+// https://epsg.io/4313
+//
+// It is used in Belgium.
+func Belge1972() Datum {
+	return Datum{
+		Spheroid: International1924{},
+		Transformation: Helmert{
+			Tx: -106.8686,
+			Ty: 52.2978,
+			Tz: -103.7239,
+			Rx: 0.3366,
+			Ry: -0.457,
+			Rz: 1.8422,
+			Ds: -1.2747,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 2.54 && lon <= 6.41 && lat >= 49.5 && lat <= 51.51
+		}),
+	}
+}
+
+// NZGD2000 provides a Datum similar to the New Zealand Geodetic Datum 2000.
+//
+// It's based on the GRS80 Spheroid and realized in ITRF96.
+//
+// https://epsg.io/4167
+//
+// It is used in New Zealand.
+func NZGD2000() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return (lon >= 160.6 || lon <= -171.2) && lat >= -55.95 && lat <= -25.88
+		}),
+	}
+}
+
+// NZGD49 provides a Datum similar to the New Zealand Geodetic Datum 1949.
+//
+// It's based on the International1924 Spheroid and a 7-parameter-Helmert-
+// Transformation with the parameters: 59.47,-5.04,187.44,0.47,-0.1,1.024,-4.5993.
+//
+// https://epsg.io/4272
+//
+// It is used in New Zealand.
+func NZGD49() Datum {
+	return Datum{
+		Spheroid: International1924{},
+		Transformation: Helmert{
+			Tx: 59.47,
+			Ty: -5.04,
+			Tz: 187.44,
+			Rx: 0.47,
+			Ry: -0.1,
+			Rz: 1.024,
+			Ds: -4.5993,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 165.87 && lon <= 179.27 && lat >= -48.06 && lat <= -33.89
+		}),
+	}
+}
+
+// IRENET95 provides a Datum similar to the IRENET95, the realization of
+// ETRS89 in Ireland.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/4173
+//
+// It is used in Ireland and Northern Ireland.
+func IRENET95() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -10.56 && lon <= -5.34 && lat >= 51.39 && lat <= 55.43
+		}),
+	}
+}
+
+// SWEREF99 provides a Datum similar to the SWEREF99, the realization of
+// ETRS89 in Sweden.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/4619
+//
+// It is used in Sweden.
+func SWEREF99() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 10.03 && lon <= 24.17 && lat >= 54.96 && lat <= 69.07
+		}),
+	}
+}
+
+// Amersfoort provides a Datum similar to the Amersfoort.
+//
+// It's based on the Bessel Spheroid and a 7-parameter-Helmert-Transformation
+// with the parameters: 565.2369,50.0087,465.658,-0.406857,0.350733,-1.87035,4.0812.
+//
+// https://epsg.io/4289
+//
+// It is used in the Netherlands.
+func Amersfoort() Datum {
+	return Datum{
+		Spheroid: Bessel{},
+		Transformation: Helmert{
+			Tx: 565.2369,
+			Ty: 50.0087,
+			Tz: 465.658,
+			Rx: -0.406857,
+			Ry: 0.350733,
+			Rz: -1.87035,
+			Ds: 4.0812,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 3.2 && lon <= 7.22 && lat >= 50.75 && lat <= 53.7
+		}),
+	}
+}
+
+// CH1903 provides a Datum similar to the CH1903.
+//
+// It's based on the Bessel Spheroid and a 3-parameter-Helmert-Transformation
+// with the parameters: 674.4,15.1,405.3.
+//
+// https://epsg.io/4149
+//
+// It is used in Switzerland and Liechtenstein.
+func CH1903() Datum {
+	return Datum{
+		Spheroid: Bessel{},
+		Transformation: Helmert{
+			Tx: 674.4,
+			Ty: 15.1,
+			Tz: 405.3,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 5.96 && lon <= 10.49 && lat >= 45.82 && lat <= 47.81
+		}),
+	}
+}
+
+// CH1903Plus provides a Datum similar to the CH1903+.
+//
+// It's based on the Bessel Spheroid and a 3-parameter-Helmert-Transformation
+// with the parameters: 674.374,15.056,405.346.
+//
+// https://epsg.io/4150
+//
+// It is used in Switzerland and Liechtenstein.
+func CH1903Plus() Datum {
+	return Datum{
+		Spheroid: Bessel{},
+		Transformation: Helmert{
+			Tx: 674.374,
+			Ty: 15.056,
+			Tz: 405.346,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 5.96 && lon <= 10.49 && lat >= 45.82 && lat <= 47.81
+		}),
+	}
+}
+
+// JGD2000 provides a Datum similar to the Japanese Geodetic Datum 2000.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/4612
+//
+// It is used in Japan.
+func JGD2000() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 122.38 && lon <= 157.65 && lat >= 17.09 && lat <= 46.05
+		}),
+	}
+}
+
+// JGD2011 provides a Datum similar to the Japanese Geodetic Datum 2011, which
+// replaced JGD2000 after the Tohoku earthquake.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/6668
+//
+// It is used in Japan.
+func JGD2011() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 122.38 && lon <= 157.65 && lat >= 17.09 && lat <= 46.05
+		}),
+	}
+}
+
+// SIRGAS2000 provides a Datum similar to the Sistema de Referencia Geocéntrico
+// para las Américas 2000, aligned with ITRF2000.
+//
+// It's based on the GRS80 Spheroid.
+//
+// https://epsg.io/4674
+//
+// It is used in Latin America.
+func SIRGAS2000() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -122.19 && lon <= -25.28 && lat >= -59.87 && lat <= 32.72
+		}),
+	}
+}
+
+// NAD27 provides a Datum similar to the North American Datum 1927 in the
+// conterminous United States.
+//
+// It's based on the Clarke1866 Spheroid and a 3-parameter-Helmert-
+// Transformation with the mean parameters for CONUS: -8,160,176.
+//
+// https://epsg.io/4267
+//
+// It is used in the United States. The shift is accurate to about 10 meters.
+func NAD27() Datum {
+	return Datum{
+		Spheroid: Clarke1866{},
+		Transformation: Helmert{
+			Tx: -8,
+			Ty: 160,
+			Tz: 176,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -124.79 && lon <= -66.91 && lat >= 24.41 && lat <= 49.38
+		}),
+	}
+}
+
+// NAD27Alaska provides a Datum similar to the North American Datum 1927 in
+// Alaska.
+//
+// It's based on the Clarke1866 Spheroid and a 3-parameter-Helmert-
+// Transformation with the parameters for Alaska without the Aleutian Islands:
+// -5,135,172.
+//
+// https://epsg.io/4267
+//
+// It is used in Alaska. The shift is accurate to about 10 meters.
+func NAD27Alaska() Datum {
+	return Datum{
+		Spheroid: Clarke1866{},
+		Transformation: Helmert{
+			Tx: -5,
+			Ty: 135,
+			Tz: 172,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -168.25 && lon <= -129.99 && lat >= 54.34 && lat <= 71.4
+		}),
+	}
+}
+
+// NAD27Canada provides a Datum similar to the North American Datum 1927 in
+// Canada.
+//
+// It's based on the Clarke1866 Spheroid and a 3-parameter-Helmert-
+// Transformation with the mean parameters for Canada: -10,158,187.
+//
+// https://epsg.io/4267
+//
+// It is used in Canada. The shift is accurate to about 15 meters.
+func NAD27Canada() Datum {
+	return Datum{
+		Spheroid: Clarke1866{},
+		Transformation: Helmert{
+			Tx: -10,
+			Ty: 158,
+			Tz: 187,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -141.01 && lon <= -47.74 && lat >= 40.04 && lat <= 83.17
+		}),
+	}
+}
+
+// OldHawaiian provides a Datum similar to the Old Hawaiian, the legacy datum
+// of Hawaii, where NAD27 was never used.
+//
+// It's based on the Clarke1866 Spheroid and a 3-parameter-Helmert-
+// Transformation with the mean parameters: 61,-285,-181.
+//
+// https://epsg.io/4135
+//
+// It is used in Hawaii. The shift is accurate to about 25 meters.
+func OldHawaiian() Datum {
+	return Datum{
+		Spheroid: Clarke1866{},
+		Transformation: Helmert{
+			Tx: 61,
+			Ty: -285,
+			Tz: -181,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= -160.3 && lon <= -154.74 && lat >= 18.87 && lat <= 22.29
+		}),
+	}
+}
+
+// Pulkovo1942 provides a Datum similar to the Pulkovo 1942.
+//
+// It's based on the Krassowsky1940 Spheroid and a 7-parameter-Helmert-
+// Transformation with the parameters for Russia:
+// 23.92,-141.27,-80.9,0,0.35,0.82,-0.12.
+//
+// https://epsg.io/4284
+//
+// It is used in Russia and other post-Soviet countries.
+func Pulkovo1942() Datum {
+	return Datum{
+		Spheroid: Krassowsky1940{},
+		Transformation: Helmert{
+			Tx: 23.92,
+			Ty: -141.27,
+			Tz: -80.9,
+			Ry: 0.35,
+			Rz: 0.82,
+			Ds: -0.12,
+		},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return (lon >= 19.57 || lon <= -168.97) && lat >= 35.14 && lat <= 81.91
+		}),
+	}
+}
+
+// CGCS2000 provides a Datum similar to the China Geodetic Coordinate System
+// 2000.
+//
+// It's based on the GRS80 Spheroid and realized in ITRF97 at the epoch 2000.0,
+// so it's compatible with WGS84 at the decimeter level.
+//
+// https://epsg.io/4490
+//
+// It is used in China.
+func CGCS2000() Datum {
+	return Datum{
+		Spheroid: GRS80{},
+		Area: AreaFunc(func(lon, lat float64) bool {
+			return lon >= 73.62 && lon <= 134.77 && lat >= 16.7 && lat <= 53.56
+		}),
+	}
+}
@@ -0,0 +1,204 @@
+package datum
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// NTv2 is a Grid in the National Transformation version 2 format, also used
+// by the Austrian GIS-Grid and the Irish and Spanish datum shift grids.
+//
+// The subgrid with the finest resolution that contains a point is used.
+type NTv2 struct {
+	subgrids []ntv2Subgrid
+}
+
+type ntv2Subgrid struct {
+	// bounds and increments in degrees, longitudes positive west.
+	south, north, east, west, latInc, lonInc float64
+	rows, cols                               int
+	// shifts in the unit of the grid, longitudes positive west.
+	dlat, dlon []float32
+	unit       float64
+}
+
+// ReadNTv2 reads a Grid in the binary NTv2 format of little or big endian
+// byte order.
+func ReadNTv2(r io.Reader) (*NTv2, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < 176 || string(data[:8]) != "NUM_OREC" {
+		return nil, fmt.Errorf("%w: no NTv2 header", ErrInvalidGrid)
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if order.Uint32(data[8:12]) != 11 {
+		order = binary.BigEndian
+	}
+
+	header := ntv2Records{data: data[:176], order: order}
+
+	if header.int("NUM_OREC") != 11 || header.int("NUM_SREC") != 11 {
+		return nil, fmt.Errorf("%w: unexpected NTv2 record counts", ErrInvalidGrid)
+	}
+
+	var unit float64
+
+	switch header.text("GS_TYPE") {
+	case "SECONDS":
+		unit = 1.0 / 3600
+	case "MINUTES":
+		unit = 1.0 / 60
+	case "DEGREES":
+		unit = 1
+	default:
+		return nil, fmt.Errorf("%w: NTv2 unit %q", ErrInvalidGrid, header.text("GS_TYPE"))
+	}
+
+	g := &NTv2{}
+	data = data[176:]
+
+	for i := 0; i < header.int("NUM_FILE"); i++ {
+		if len(data) < 176 {
+			return nil, fmt.Errorf("%w: truncated NTv2 subgrid %d", ErrInvalidGrid, i)
+		}
+
+		sub := ntv2Records{data: data[:176], order: order}
+		s := ntv2Subgrid{
+			south:  sub.float("S_LAT") * unit,
+			north:  sub.float("N_LAT") * unit,
+			east:   sub.float("E_LONG") * unit,
+			west:   sub.float("W_LONG") * unit,
+			latInc: sub.float("LAT_INC") * unit,
+			lonInc: sub.float("LONG_INC") * unit,
+			unit:   unit,
+		}
+
+		if !(s.latInc > 0 && s.lonInc > 0 && s.north >= s.south && s.west >= s.east) {
+			return nil, fmt.Errorf("%w: NTv2 subgrid %d bounds", ErrInvalidGrid, i)
+		}
+
+		s.rows = int(math.Round((s.north-s.south)/s.latInc)) + 1
+		s.cols = int(math.Round((s.west-s.east)/s.lonInc)) + 1
+		count := sub.int("GS_COUNT")
+
+		if count != s.rows*s.cols || len(data) < 176+16*count {
+			return nil, fmt.Errorf("%w: NTv2 subgrid %d has %d nodes", ErrInvalidGrid, i, count)
+		}
+
+		s.dlat = make([]float32, count)
+		s.dlon = make([]float32, count)
+
+		for n := 0; n < count; n++ {
+			node := data[176+16*n:]
+			s.dlat[n] = math.Float32frombits(order.Uint32(node[0:4]))
+			s.dlon[n] = math.Float32frombits(order.Uint32(node[4:8]))
+		}
+
+		g.subgrids = append(g.subgrids, s)
+		data = data[176+16*count:]
+	}
+
+	if len(g.subgrids) == 0 {
+		return nil, fmt.Errorf("%w: no NTv2 subgrids", ErrInvalidGrid)
+	}
+
+	return g, nil
+}
+
+// Shift returns the bilinear-interpolated shifts in degrees of the finest
+// subgrid containing the point. Longitudes are positive east.
+func (g *NTv2) Shift(lon, lat float64) (dlon, dlat float64, ok bool) {
+	var best *ntv2Subgrid
+
+	for i := range g.subgrids {
+		s := &g.subgrids[i]
+		if s.contains(-lon, lat) && (best == nil || s.latInc*s.lonInc < best.latInc*best.lonInc) {
+			best = s
+		}
+	}
+
+	if best == nil {
+		return 0, 0, false
+	}
+
+	dlonWest, dlat := best.interpolate(-lon, lat)
+
+	return -dlonWest, dlat, true
+}
+
+func (s *ntv2Subgrid) contains(west, lat float64) bool {
+	return lat >= s.south && lat <= s.north && west >= s.east && west <= s.west
+}
+
+func (s *ntv2Subgrid) interpolate(west, lat float64) (dlon, dlat float64) {
+	x := (west - s.east) / s.lonInc
+	y := (lat - s.south) / s.latInc
+	col := int(math.Min(math.Floor(x), float64(s.cols-2)))
+	row := int(math.Min(math.Floor(y), float64(s.rows-2)))
+
+	if s.cols == 1 {
+		col = 0
+	}
+
+	if s.rows == 1 {
+		row = 0
+	}
+
+	fx, fy := x-float64(col), y-float64(row)
+
+	node := func(v []float32, r, c int) float64 {
+		if r >= s.rows {
+			r = s.rows - 1
+		}
+
+		if c >= s.cols {
+			c = s.cols - 1
+		}
+
+		return float64(v[r*s.cols+c])
+	}
+
+	bilinear := func(v []float32) float64 {
+		return s.unit * ((1-fy)*((1-fx)*node(v, row, col)+fx*node(v, row, col+1)) +
+			fy*((1-fx)*node(v, row+1, col)+fx*node(v, row+1, col+1)))
+	}
+
+	return bilinear(s.dlon), bilinear(s.dlat)
+}
+
+// ntv2Records are the 16-byte records of a NTv2 header, an 8-byte name and an
+// 8-byte value.
+type ntv2Records struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+func (h ntv2Records) value(name string) []byte {
+	for i := 0; i+16 <= len(h.data); i += 16 {
+		if strings.TrimSpace(string(bytes.TrimRight(h.data[i:i+8], "\x00"))) == name {
+			return h.data[i+8 : i+16]
+		}
+	}
+
+	return make([]byte, 8)
+}
+
+func (h ntv2Records) int(name string) int {
+	return int(int32(h.order.Uint32(h.value(name))))
+}
+
+func (h ntv2Records) float(name string) float64 {
+	return math.Float64frombits(h.order.Uint64(h.value(name)))
+}
+
+func (h ntv2Records) text(name string) string {
+	return strings.TrimSpace(string(bytes.TrimRight(h.value(name), "\x00")))
+}
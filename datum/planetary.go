@@ -0,0 +1,83 @@
+package datum
+
+import "math"
+
+// MarsIAU2015 is the biaxial spheroid of Mars adopted by the IAU in 2015.
+type MarsIAU2015 struct{}
+
+// A returns the major axis of the spheroid.
+func (MarsIAU2015) A() float64 {
+	return 3396190
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (MarsIAU2015) Fi() float64 {
+	return 3396190.0 / (3396190 - 3376200)
+}
+
+// Mars provides a Datum for the planet Mars.
+//
+// It's based on the MarsIAU2015 Spheroid and has no Transformation to
+// Datums of other bodies.
+func Mars() Datum {
+	return Datum{
+		Spheroid: MarsIAU2015{},
+		Area:     AreaFunc(nil),
+		Body:     "Mars",
+	}
+}
+
+// MoonIAU2015 is the spherical mean radius of the Moon adopted by the IAU in
+// 2015.
+type MoonIAU2015 struct{}
+
+// A returns the major axis of the spheroid.
+func (MoonIAU2015) A() float64 {
+	return 1737400
+}
+
+// Fi returns the inverse Flattening of the spheroid, which is infinite for a
+// sphere.
+func (MoonIAU2015) Fi() float64 {
+	return math.Inf(1)
+}
+
+// Moon provides a Datum for the Moon as used by the Lunar Reconnaissance
+// Orbiter and its Lunar Orbiter Laser Altimeter.
+//
+// It's based on the MoonIAU2015 Spheroid and has no Transformation to
+// Datums of other bodies.
+func Moon() Datum {
+	return Datum{
+		Spheroid: MoonIAU2015{},
+		Area:     AreaFunc(nil),
+		Body:     "Moon",
+	}
+}
+
+// TitanMean is the sphere with the mean radius of Titan used for the RADAR
+// mapping of the Cassini mission.
+type TitanMean struct{}
+
+// A returns the major axis of the spheroid.
+func (TitanMean) A() float64 {
+	return 2574730
+}
+
+// Fi returns the inverse Flattening of the spheroid, which is infinite for a
+// sphere.
+func (TitanMean) Fi() float64 {
+	return math.Inf(1)
+}
+
+// Titan provides a Datum for the Saturn moon Titan.
+//
+// It's based on the TitanMean Spheroid and has no Transformation to Datums
+// of other bodies.
+func Titan() Datum {
+	return Datum{
+		Spheroid: TitanMean{},
+		Area:     AreaFunc(nil),
+		Body:     "Titan",
+	}
+}
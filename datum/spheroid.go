@@ -0,0 +1,148 @@
+package datum
+
+// WGS84Ellipsoid is the spheroid of the World Geodetic System 1984.
+type WGS84Ellipsoid struct{}
+
+// A returns the major axis of the spheroid.
+func (WGS84Ellipsoid) A() float64 {
+	return 6378137
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (WGS84Ellipsoid) Fi() float64 {
+	return 298.257223563
+}
+
+// GRS80 is a spheroid used by several geodetic datums.
+type GRS80 struct{}
+
+// A returns the major axis of the spheroid.
+func (GRS80) A() float64 {
+	return 6378137
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (GRS80) Fi() float64 {
+	return 298.257222101
+}
+
+// Airy is a spheroid used by several geodetic datums.
+type Airy struct{}
+
+// A returns the major axis of the spheroid.
+func (Airy) A() float64 {
+	return 6377563.396
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (Airy) Fi() float64 {
+	return 299.3249646
+}
+
+// Bessel is a spheroid used by several geodetic datums.
+type Bessel struct{}
+
+// A returns the major axis of the spheroid.
+func (Bessel) A() float64 {
+	return 6377397.155
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (Bessel) Fi() float64 {
+	return 299.1528128
+}
+
+// Clarke1866 is a spheroid used by several geodetic datums.
+type Clarke1866 struct{}
+
+// A returns the major axis of the spheroid.
+func (Clarke1866) A() float64 {
+	return 6378206.4
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (Clarke1866) Fi() float64 {
+	return 294.9786982139006
+}
+
+// International1924 is a spheroid used by several geodetic datums.
+//
+// It is also known as Hayford 1909.
+type International1924 struct{}
+
+// A returns the major axis of the spheroid.
+func (International1924) A() float64 {
+	return 6378388
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (International1924) Fi() float64 {
+	return 297
+}
+
+// Clarke1858 is a spheroid used by several geodetic datums.
+//
+// Its axes are defined in Clarke's feet of 0.3047972654 meters.
+type Clarke1858 struct{}
+
+// A returns the major axis of the spheroid.
+func (Clarke1858) A() float64 {
+	return 6378293.645208759
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (Clarke1858) Fi() float64 {
+	return 294.26067636926064
+}
+
+// AiryModified is a spheroid used by several geodetic datums.
+type AiryModified struct{}
+
+// A returns the major axis of the spheroid.
+func (AiryModified) A() float64 {
+	return 6377340.189
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (AiryModified) Fi() float64 {
+	return 299.3249646
+}
+
+// Clarke1880IGN is a spheroid used by several geodetic datums.
+type Clarke1880IGN struct{}
+
+// A returns the major axis of the spheroid.
+func (Clarke1880IGN) A() float64 {
+	return 6378249.2
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (Clarke1880IGN) Fi() float64 {
+	return 293.4660212936269
+}
+
+// GRS67Modified is a spheroid used by several geodetic datums.
+type GRS67Modified struct{}
+
+// A returns the major axis of the spheroid.
+func (GRS67Modified) A() float64 {
+	return 6378160
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (GRS67Modified) Fi() float64 {
+	return 298.25
+}
+
+// Krassowsky1940 is a spheroid used by several geodetic datums.
+type Krassowsky1940 struct{}
+
+// A returns the major axis of the spheroid.
+func (Krassowsky1940) A() float64 {
+	return 6378245
+}
+
+// Fi returns the inverse Flattening of the spheroid.
+func (Krassowsky1940) Fi() float64 {
+	return 298.3
+}
@@ -0,0 +1,92 @@
+package wgs84
+
+import (
+	"fmt"
+	"math"
+)
+
+// DiagnoseInverse checks the inverse projection of a projected Coordinate
+// Reference System at a projected coordinate.
+//
+// The result of the inverse of the Projection is corrected by at most maxIter
+// Newton-Raphson iterations with the numerical Jacobian of the forward
+// projection, none if maxIter isn't positive. iterations is the number of
+// corrections needed, 0 if the inverse of the Projection is already exact.
+// converged reports whether the forward projection of lon and lat is within
+// 1e-6 meters of the coordinate.
+//
+// Returns NaN and false if the Projection is nil or the inverse is NaN.
+func DiagnoseInverse(crs ProjectedReferenceSystem, easting, northing float64, maxIter int) (lon, lat float64, iterations int, converged bool) {
+	p := crs.Projection
+	if p == nil {
+		return math.NaN(), math.NaN(), 0, false
+	}
+
+	s := spheroidOf(crs.Datum)
+	forward := func(lon, lat float64) (float64, float64) { return p.FromLonLat(lon, lat, s) }
+	lon, lat = p.ToLonLat(easting, northing, s)
+
+	for ; ; iterations++ {
+		var done bool
+
+		lon, lat, done = newtonStep(forward, easting, northing, lon, lat, 1e-6, iterations < maxIter)
+		if done {
+			return lon, lat, iterations, true
+		}
+
+		if iterations >= maxIter || math.IsNaN(lon+lat) {
+			return lon, lat, iterations, false
+		}
+	}
+}
+
+// NumericalInverse inverts a forward projection by Newton-Raphson iteration
+// with a numerical Jacobian, starting at lon0 and lat0.
+//
+// It's meant for projections without a closed-form inverse. tol is the
+// accepted distance of the forward projection of lon and lat to easting and
+// northing. An error wrapping ErrNoConvergence is returned with the last
+// estimate if it's not reached within 50 iterations.
+func NumericalInverse(forward func(lon, lat float64) (easting, northing float64), easting, northing, lon0, lat0 float64, tol float64) (lon, lat float64, err error) {
+	lon, lat = lon0, lat0
+
+	for i := 0; i < 50; i++ {
+		var done bool
+
+		lon, lat, done = newtonStep(forward, easting, northing, lon, lat, tol, true)
+		if done {
+			return lon, lat, nil
+		}
+
+		if math.IsNaN(lon + lat) {
+			break
+		}
+	}
+
+	return lon, lat, fmt.Errorf("%w at %v,%v", ErrNoConvergence, easting, northing)
+}
+
+// newtonStep reports whether lon and lat are within tol of easting and
+// northing, otherwise they are corrected once if step is set.
+func newtonStep(forward func(lon, lat float64) (float64, float64), easting, northing, lon, lat, tol float64, step bool) (float64, float64, bool) {
+	const h = 1e-7
+
+	east, north := forward(lon, lat)
+	de, dn := easting-east, northing-north
+
+	if math.Hypot(de, dn) <= tol {
+		return lon, lat, true
+	}
+
+	if !step {
+		return lon, lat, false
+	}
+
+	e1, n1 := forward(lon+h, lat)
+	e2, n2 := forward(lon, lat+h)
+	dEdλ, dNdλ := (e1-east)/h, (n1-north)/h
+	dEdφ, dNdφ := (e2-east)/h, (n2-north)/h
+	d := dEdλ*dNdφ - dEdφ*dNdλ
+
+	return lon + (dNdφ*de-dEdφ*dn)/d, lat + (dEdλ*dn-dNdλ*de)/d, false
+}
@@ -0,0 +1,69 @@
+package wgs84_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+	"github.com/wroge/wgs84/proj"
+)
+
+func TestDiagnoseInverse(t *testing.T) {
+	t.Parallel()
+
+	utm := wgs84.UTM(31, true)
+	east, north := utm.Projection.FromLonLat(0.5, 52, utm.Datum)
+
+	lon, lat, iterations, converged := wgs84.DiagnoseInverse(utm, east, north, 10)
+	if !converged || iterations != 0 || math.Abs(lon-0.5) > 1e-9 || math.Abs(lat-52) > 1e-9 {
+		t.Fatal(lon, lat, iterations, converged)
+	}
+
+	edge := wgs84.ProjectedReferenceSystem{
+		Datum:      utm.Datum,
+		Projection: edgeProjection{TransverseMercator: utm.Projection.(proj.TransverseMercator)},
+		Area:       utm.Area,
+	}
+
+	lon, lat, iterations, converged = wgs84.DiagnoseInverse(edge, east, north, 10)
+	if !converged || iterations == 0 || math.Abs(lon-0.5) > 1e-9 || math.Abs(lat-52) > 1e-9 {
+		t.Fatal(lon, lat, iterations, converged)
+	}
+
+	for _, maxIter := range []int{0, -1} {
+		if _, _, iterations, converged = wgs84.DiagnoseInverse(edge, east, north, maxIter); converged || iterations != 0 {
+			t.Fatal(maxIter, iterations, converged)
+		}
+	}
+
+	if _, _, iterations, converged = wgs84.DiagnoseInverse(utm, east, north, -1); !converged || iterations != 0 {
+		t.Fatal(iterations, converged)
+	}
+
+	if lon, _, _, converged = wgs84.DiagnoseInverse(wgs84.ProjectedReferenceSystem{}, east, north, 10); converged || !math.IsNaN(lon) {
+		t.Fatal(lon, converged)
+	}
+}
+
+func TestNumericalInverse(t *testing.T) {
+	t.Parallel()
+
+	crs := wgs84.SwissLV95()
+	forward := func(lon, lat float64) (float64, float64) {
+		return crs.Projection.FromLonLat(lon, lat, crs.Datum)
+	}
+
+	east, north := forward(8.5, 47.25)
+
+	lon, lat, err := wgs84.NumericalInverse(forward, east, north, 7.439583333333333, 46.952405555555565, 1e-6)
+	if err != nil || math.Abs(lon-8.5) > 1e-9 || math.Abs(lat-47.25) > 1e-9 {
+		t.Fatal(lon, lat, err)
+	}
+
+	constant := func(lon, lat float64) (float64, float64) { return 0, 0 }
+
+	if _, _, err := wgs84.NumericalInverse(constant, east, north, 0, 0, 1e-6); !errors.Is(err, wgs84.ErrNoConvergence) {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,43 @@
+package wgs84
+
+import "math"
+
+// CRSDiffEntry is the result of the comparison of two Coordinate Reference
+// System's at one point.
+type CRSDiffEntry struct {
+	// Input is the WGS84 geographic coordinate.
+	Input [3]float64
+	// A is the coordinate in the first CoordinateReferenceSystem.
+	A [3]float64
+	// B is the coordinate in the second CoordinateReferenceSystem.
+	B [3]float64
+	// Delta is the geocentric distance in meters between A and B, when B is
+	// interpreted as a coordinate of the first CoordinateReferenceSystem.
+	Delta float64
+}
+
+// Diff compares two Coordinate Reference System's at WGS84 geographic test
+// points.
+//
+// It is useful to validate a CoordinateReferenceSystem against a reference
+// implementation.
+func Diff(a, b CoordinateReferenceSystem, testPoints [][3]float64) []CRSDiffEntry {
+	entries := make([]CRSDiffEntry, len(testPoints))
+	wgs84 := WGS84LonLat()
+
+	for i, p := range testPoints {
+		x, y, z := wgs84.ToWGS84(p[0], p[1], p[2])
+		a0, a1, a2 := a.FromWGS84(x, y, z)
+		b0, b1, b2 := b.FromWGS84(x, y, z)
+		xb, yb, zb := a.ToWGS84(b0, b1, b2)
+
+		entries[i] = CRSDiffEntry{
+			Input: p,
+			A:     [3]float64{a0, a1, a2},
+			B:     [3]float64{b0, b1, b2},
+			Delta: math.Sqrt((xb-x)*(xb-x) + (yb-y)*(yb-y) + (zb-z)*(zb-z)),
+		}
+	}
+
+	return entries
+}
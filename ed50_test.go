@@ -0,0 +1,49 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestED50SpainUTM30(t *testing.T) {
+	t.Parallel()
+
+	crs := wgs84.ED50SpainUTM30()
+	if crs.EPSGCode() != 23030 || crs.Name() != "ED50 / UTM zone 30N" {
+		t.Fatal(crs.EPSGCode(), crs.Name())
+	}
+
+	// The UTM example of Snyder's Map Projections - A Working Manual, 40°30'N
+	// 73°30'W on Clarke 1866 is 127106.5 m east of and 4484124.4 m north of
+	// the origin of zone 18. Zone 30 is the same 72° further east.
+	if e, n := crs.Projection.FromLonLat(-1.5, 40.5, wgs84.Clarke1866{}); math.Abs(e-627106.5) > 0.05 || math.Abs(n-4484124.4) > 0.05 {
+		t.Fatal(e, n)
+	}
+
+	// Madrid, Barcelona and Sevilla. Barcelona is outside of the strip of
+	// zone 30.
+	for _, p := range [][2]float64{{-3.7038, 40.4168}, {2.1734, 41.3851}, {-5.9845, 37.3891}} {
+		if !crs.Contains(p[0], p[1]) {
+			t.Fatal(p)
+		}
+
+		east, north, _ := wgs84.Transform(wgs84.WGS84LonLat(), crs)(p[0], p[1], 0)
+		lon, lat, _ := wgs84.Transform(crs, wgs84.WGS84LonLat())(east, north, 0)
+
+		if d := wgs84.Distance(lon, lat, p[0], p[1], wgs84.WGS84()); d > 0.01 {
+			t.Fatal(p, d)
+		}
+
+		// ED50 is shifted by about 100 m east and 200 m north in Spain.
+		e, n, _ := wgs84.Transform(wgs84.WGS84LonLat(), wgs84.ETRS89UTM(30))(p[0], p[1], 0)
+		if d := math.Hypot(e-east, n-north); d < 150 || d > 300 {
+			t.Fatal(p, e-east, n-north)
+		}
+	}
+
+	if !wgs84.ED50UTM(30).Contains(-3, 40) || wgs84.ED50UTM(30).Contains(2, 41) || wgs84.ED50UTM(40).Contains(57, 40) {
+		t.Fatal("area")
+	}
+}
@@ -1,20 +1,39 @@
 package wgs84
 
 import (
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 )
 
+// EPSGVersion is the version of the EPSG dataset the parameters of the
+// Repository are taken from.
+const EPSGVersion = "10.027"
+
+// EPSGDate is the release date of the EPSG dataset version.
+var EPSGDate = time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// EPSGVersionString returns the version and release date of the EPSG
+// dataset, like "EPSG v10.027 (2023-01-01)".
+func EPSGVersionString() string {
+	return "EPSG v" + EPSGVersion + " (" + EPSGDate.Format("2006-01-02") + ")"
+}
+
 // EPSG returns a Repository for dealing with several EPSG-Codes and
 // CoordinateReferenceSystems.
 func EPSG() *Repository {
 	codes := map[int]CoordinateReferenceSystem{
-		4326:   LonLat(),
-		4978:   XYZ(),
+		4326:   WGS84LonLat(),
+		4978:   WGS84XYZ(),
 		3857:   WebMercator(),
 		900913: WebMercator(),
-		4258:   ETRS89().LonLat(),
+		32662:  PlateCarree(),
+		4258:   ETRS89().LonLat().withMetadata(4258, "ETRS89"),
 		3416:   ETRS89AustriaLambert(),
-		3035:   ETRS89LambertAzimuthalEqualArea(),
+		3035:   ETRS89LAEA(),
+		3031:   AntarcticPolarStereographic(),
+		3413:   ArcticPolarStereographic(),
 		31287:  MGIAustriaLambert(),
 		31284:  MGIAustriaM28(),
 		31285:  MGIAustriaM31(),
@@ -22,15 +41,73 @@ func EPSG() *Repository {
 		31257:  MGIAustriaGKM28(),
 		31258:  MGIAustriaGKM31(),
 		31259:  MGIAustriaGKM34(),
-		4314:   DHDN2001().LonLat(),
+		4314:   DHDN2001().LonLat().withMetadata(4314, "DHDN"),
 		27700:  OSGB36NationalGrid(),
-		4277:   OSGB36().LonLat(),
-		4171:   RGF93().LonLat(),
+		4277:   OSGB36().LonLat().withMetadata(4277, "OSGB36"),
+		4171:   RGF93().LonLat().withMetadata(4171, "RGF93"),
 		2154:   RGF93FranceLambert(),
-		4269:   NAD83().LonLat(),
+		4275:   NTF().LonLat().withMetadata(4275, "NTF"),
+		4269:   NAD83().LonLat().withMetadata(4269, "NAD83"),
+		6783:   NAD83CORS96(2002).LonLat().withMetadata(6783, "NAD83(CORS96)"),
 		6355:   NAD83AlabamaEast(),
 		6356:   NAD83AlabamaWest(),
 		6414:   NAD83CaliforniaAlbers(),
+		4170:   SIRGAS95().LonLat().withMetadata(4170, "SIRGAS 1995"),
+		5489:   RGAF09().LonLat().withMetadata(5489, "RGAF09"),
+		4758:   JAD2001().LonLat().withMetadata(4758, "JAD2001"),
+		6135:   CIGD11().LonLat().withMetadata(6135, "CIGD11"),
+		6365:   MexicoITRF2008().LonLat().withMetadata(6365, "Mexico ITRF2008"),
+		5340:   POSGAR07().LonLat().withMetadata(5340, "POSGAR 2007"),
+		4248:   PSAD56().LonLat().withMetadata(4248, "PSAD56"),
+		4686:   MAGNA().LonLat().withMetadata(4686, "MAGNA-SIRGAS"),
+		3114:   ColombiaFarWest(),
+		3115:   ColombiaWest(),
+		3116:   ColombiaBogota(),
+		3117:   ColombiaEastCentral(),
+		3118:   ColombiaEast(),
+		4189:   REGVEN().LonLat().withMetadata(4189, "REGVEN"),
+		2202:   REGVENUTMZone19N(),
+		2203:   REGVENUTMZone20N(),
+		4742:   GDM2000().LonLat().withMetadata(4742, "GDM2000"),
+		3375:   MalaysiaRSO(),
+		5513:   SJTSK(),
+		5514:   SJTSKEastNorth(),
+		4302:   Trinidad1903().LonLat().withMetadata(4302, "Trinidad 1903"),
+		30200:  TrinidadGrid(),
+		3993:   GuamMapGrid(),
+		4300:   Ireland1965().LonLat().withMetadata(4300, "TM75"),
+		29903:  IrishNationalGrid(),
+		4173:   IRENET95().LonLat().withMetadata(4173, "IRENET95"),
+		2157:   IrishTransverseMercator(),
+		4619:   SWEREF99().LonLat().withMetadata(4619, "SWEREF99"),
+		3006:   SWEREF99TM(),
+		4289:   Amersfoort().LonLat().withMetadata(4289, "Amersfoort"),
+		28992:  RDNew(),
+		4149:   CH1903().LonLat().withMetadata(4149, "CH1903"),
+		21781:  SwissLV03(),
+		4150:   CH1903Plus().LonLat().withMetadata(4150, "CH1903+"),
+		2056:   SwissLV95(),
+		4612:   JGD2000().LonLat().withMetadata(4612, "JGD2000"),
+		6668:   JGD2011().LonLat().withMetadata(6668, "JGD2011"),
+		4267:   NAD27().LonLat().withMetadata(4267, "NAD27"),
+		4135:   OldHawaiian().LonLat().withMetadata(4135, "Old Hawaiian"),
+		4284:   Pulkovo1942LonLat(),
+		4490:   CGCS2000LonLat(),
+		4230:   ED50().LonLat().withMetadata(4230, "ED50"),
+		4265:   MonteMario().LonLat().withMetadata(4265, "Monte Mario"),
+		4618:   SAD69().LonLat().withMetadata(4618, "SAD69"),
+		29101:  BrazilPolyconic(),
+		4674:   SIRGAS2000LonLat(),
+		5880:   SIRGAS2000BrazilPolyconic(),
+		3005:   BCAlbers(),
+		7844:   GDA2020().LonLat().withMetadata(7844, "GDA2020"),
+		9473:   AustraliaAlbers(),
+		4313:   Belge1972().LonLat().withMetadata(4313, "BD72"),
+		31370:  BelgiumLambert72(),
+		4167:   NZGD2000().LonLat().withMetadata(4167, "NZGD2000"),
+		2193:   NZTM2000(),
+		4272:   NZGD49().LonLat().withMetadata(4272, "NZGD49"),
+		27200:  NZMG(),
 	}
 
 	for i := 1; i < 61; i++ {
@@ -38,6 +115,41 @@ func EPSG() *Repository {
 		codes[32700+i] = UTM(float64(i), false)
 	}
 
+	for zone := 1; zone <= len(japanZones); zone++ {
+		codes[6668+zone] = JapanPlaneRectangular(zone)
+	}
+
+	for zone := 18.0; zone <= 25; zone++ {
+		codes[31960+int(zone)] = BrazilUTM(zone, true)
+
+		if zone < 25 {
+			north := BrazilUTM(zone, false)
+			codes[north.EPSGCode()] = north
+		}
+	}
+
+	for zone := 13.0; zone <= 45; zone++ {
+		if crs := CGCS2000GaussKruger(zone); crs.Projection != nil {
+			codes[crs.EPSGCode()] = crs
+		}
+	}
+
+	for zone := 4.0; zone <= 32; zone++ {
+		codes[28400+int(zone)] = Pulkovo1942GK(zone)
+	}
+
+	for zone := 28.0; zone <= 38; zone++ {
+		codes[23000+int(zone)] = ED50UTM(zone)
+	}
+
+	for zone := 10.0; zone <= 19; zone++ {
+		codes[26700+int(zone)] = NAD27UTM(zone, true)
+	}
+
+	for lon, code := range sweref99Zones {
+		codes[code] = SWEREF99Zone(lon)
+	}
+
 	for i := 42; i < 51; i++ {
 		codes[3900+i] = RGF93CC(float64(i))
 	}
@@ -46,6 +158,22 @@ func EPSG() *Repository {
 		codes[31464+i] = DHDN2001GK(float64(i))
 	}
 
+	for i := 11; i < 17; i++ {
+		codes[6355+i] = MexicoUTM(float64(i))
+	}
+
+	for i := 1; i < 8; i++ {
+		codes[5342+i], _ = POSGAR07FajaX(i)
+	}
+
+	for i := 17; i < 23; i++ {
+		if i < 22 {
+			codes[24800+i] = PSAD56UTM(float64(i), true)
+		}
+
+		codes[24860+i] = PSAD56UTM(float64(i), false)
+	}
+
 	for i := 28; i < 39; i++ {
 		codes[25800+i] = ETRS89UTM(float64(i))
 	}
@@ -55,6 +183,46 @@ func EPSG() *Repository {
 	}
 }
 
+// ErrUnknownEPSGCode is returned for EPSG-Codes that are not available in
+// the EPSG Repository.
+var ErrUnknownEPSGCode = errors.New("unknown EPSG-Code")
+
+var (
+	epsgOnce       sync.Once
+	epsgRepository *Repository
+)
+
+// FromEPSG returns the CoordinateReferenceSystem of a specific EPSG-Code.
+//
+// It covers all EPSG-Codes of the EPSG Repository, for example FromEPSG(32632)
+// returns the same CoordinateReferenceSystem as UTM(32, true). Unknown
+// EPSG-Codes return an error wrapping ErrUnknownEPSGCode.
+func FromEPSG(code int) (CoordinateReferenceSystem, error) {
+	epsgOnce.Do(func() {
+		epsgRepository = EPSG()
+	})
+
+	crs := epsgRepository.Code(code)
+	if crs == nil {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownEPSGCode, code)
+	}
+
+	return crs, nil
+}
+
+// MustEPSG returns the CoordinateReferenceSystem of a specific EPSG-Code.
+//
+// It panics if the EPSG-Code is unknown. It simplifies the initialization of
+// global variables holding CoordinateReferenceSystem's.
+func MustEPSG(code int) CoordinateReferenceSystem {
+	crs, err := FromEPSG(code)
+	if err != nil {
+		panic(fmt.Sprintf("wgs84: MustEPSG(%d): %v", code, err))
+	}
+
+	return crs
+}
+
 // Repository holds the EPSG-Codes and CoordinateReferenceSystems.
 type Repository struct {
 	codes map[int]CoordinateReferenceSystem
@@ -64,7 +232,7 @@ type Repository struct {
 // Code returns a CoordinateReferenceSystem of a specific EPSG-Code.
 func (r *Repository) Code(c int) CoordinateReferenceSystem {
 	if r.codes == nil {
-		return XYZ()
+		return WGS84XYZ()
 	}
 
 	return r.codes[c]
@@ -127,5 +295,12 @@ func (r *Repository) Transform(from, to int) Func {
 // SafeTransform transforms coordinates from one EPSG-Code to another
 // with errors.
 func (r *Repository) SafeTransform(from, to int) SafeFunc {
-	return SafeTransform(r.Code(from), r.Code(to))
+	crs := r.Code(from)
+	if crs == nil {
+		return func(a, b, c float64) (float64, float64, float64, error) {
+			return 0, 0, 0, ErrNoCoordinateReferenceSystem
+		}
+	}
+
+	return SafeTransform(crs, r.Code(to))
 }
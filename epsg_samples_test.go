@@ -0,0 +1,100 @@
+package wgs84_test
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+//go:embed testdata/epsg_samples.csv
+var epsgSamples string
+
+func epsgSampleSystems() map[string][2]wgs84.CoordinateReferenceSystem {
+	airy := wgs84.Datum{Spheroid: wgs84.Airy{}}
+	clarke := wgs84.Datum{Spheroid: wgs84.Clarke1866{}}
+	bessel := wgs84.Datum{Spheroid: wgs84.Bessel{}}
+
+	return map[string][2]wgs84.CoordinateReferenceSystem{
+		"OSGB36 / British National Grid": {
+			airy.LonLat(),
+			airy.TransverseMercator(-2, 49, 0.9996012717, 400000, -100000),
+		},
+		"NAD27 / Texas South Central": {
+			clarke.LonLat(),
+			clarke.LambertConformalConic2SP(-99, 27.833333333333332, 28.383333333333333, 30.283333333333335,
+				2000000*1200.0/3937, 0),
+		},
+		"ETRS89-extended / LAEA Europe": {
+			wgs84.ETRS89().LonLat(),
+			wgs84.ETRS89LambertAzimuthalEqualArea(),
+		},
+		"S-JTSK / Krovak East North": {
+			bessel.LonLat(),
+			bessel.Krovak(24.833333333333332, 49.5, 30.288139752777777, 78.5, 0.9999, 0, 0),
+		},
+		"Makassar / NEIEZ": {
+			bessel.LonLat(),
+			bessel.Mercator(110, 0.997, 3900000, 900000),
+		},
+		"WGS 84 / Pseudo-Mercator": {
+			wgs84.WGS84LonLat(),
+			wgs84.WebMercator(),
+		},
+		"WGS 84 / UPS North": {
+			wgs84.WGS84LonLat(),
+			wgs84.WGS84().PolarStereographic(0, 90, 0.994, 2000000, 2000000),
+		},
+		"WGS 84 geographic to geocentric": {
+			wgs84.WGS84LonLat(),
+			wgs84.WGS84XYZ(),
+		},
+		"WGS 72 to WGS 84 position vector": {
+			wgs84.Helmert(6378135, 298.26, 0, 0, 4.5, 0, 0, 0.554, 0.219).XYZ(),
+			wgs84.WGS84XYZ(),
+		},
+	}
+}
+
+func TestEPSGSamples(t *testing.T) {
+	t.Parallel()
+
+	r := csv.NewReader(strings.NewReader(epsgSamples))
+	r.Comment = '#'
+
+	records, err := r.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	systems := epsgSampleSystems()
+
+	for _, record := range records {
+		crs, ok := systems[record[0]]
+		if !ok {
+			t.Fatalf("%s: unknown system", record[0])
+		}
+
+		v := make([]float64, len(record)-1)
+
+		for i, s := range record[1:] {
+			if v[i], err = strconv.ParseFloat(s, 64); err != nil {
+				t.Fatalf("%s: %v", record[0], err)
+			}
+		}
+
+		a, b, c := wgs84.Transform(crs[0], crs[1])(v[0], v[1], v[2])
+		if math.Abs(a-v[3]) > v[7] || math.Abs(b-v[4]) > v[7] || math.Abs(c-v[5]) > v[7] {
+			t.Errorf("%s: got %f %f %f, want %f %f %f", record[0], a, b, c, v[3], v[4], v[5])
+		}
+
+		a, b, _ = wgs84.Transform(crs[1], crs[0])(v[3], v[4], v[5])
+		if math.Abs(a-v[0]) > v[6] || math.Abs(b-v[1]) > v[6] {
+			t.Errorf("%s (inverse): got %f %f, want %f %f", record[0], a, b, v[0], v[1])
+		}
+	}
+}
@@ -0,0 +1,410 @@
+package wgs84_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/wroge/wgs84"
+)
+
+func ExampleUTM() {
+	east, north, h := wgs84.WGS84LonLat().To(wgs84.UTM(32, true)).Round(2)(9, 52, 0)
+	fmt.Println(east, north, h)
+	// Output:
+	// 500000 5.76103821e+06 0
+}
+
+func ExampleWebMercator() {
+	east, north, _ := wgs84.To(wgs84.WebMercator()).Round(2)(9, 52, 0)
+	fmt.Println(east, north)
+	// Output:
+	// 1.00187542e+06 6.80012545e+06
+}
+
+func ExampleETRS89UTM() {
+	east, north, _ := wgs84.WGS84LonLat().To(wgs84.ETRS89UTM(32)).Round(2)(9, 52, 0)
+	fmt.Println(east, north)
+	// Output:
+	// 500000 5.76103821e+06
+}
+
+func ExampleWGS84LonLat_toWebMercator() {
+	east, north, _ := wgs84.WGS84LonLat().To(wgs84.WebMercator()).Round(2)(-74, 40.7, 0)
+	fmt.Println(east, north)
+	// Output:
+	// -8.23764232e+06 4.96819193e+06
+}
+
+func ExampleFrom() {
+	lon, lat, _ := wgs84.From(wgs84.UTM(32, true)).Round(6)(500000, 5761038.21, 0)
+	fmt.Println(lon, lat)
+	// Output:
+	// 9 52
+}
+
+func ExampleTransform() {
+	east, north, _ := wgs84.Transform(wgs84.UTM(32, true), wgs84.UTM(33, true)).Round(2)(500000, 5761038.21, 0)
+	fmt.Println(east, north)
+	// Output:
+	// 88279.44 5.7780533e+06
+}
+
+func ExampleSafeTransform() {
+	_, _, _, err := wgs84.SafeTransform(wgs84.WGS84LonLat(), wgs84.UTM(32, true))(50, 52, 0)
+	fmt.Println(err)
+	// Output:
+	// coordinate is out of bounds
+}
+
+func ExampleSafeTransform_nil() {
+	_, _, _, err := wgs84.SafeTransform(wgs84.WGS84LonLat(), nil)(9, 52, 0)
+	fmt.Println(err)
+	// Output:
+	// crs not specified
+}
+
+func ExampleGeographicReferenceSystem_SafeTo() {
+	east, north, _, err := wgs84.WGS84LonLat().SafeTo(wgs84.OSGB36NationalGrid()).Round(0)(-1, 52, 0)
+	fmt.Println(east, north, err)
+	// Output:
+	// 468749 233978 <nil>
+}
+
+func ExampleProjectedReferenceSystem_To() {
+	east, north, _ := wgs84.UTM(32, true).To(wgs84.WGS84LonLat()).Round(0)(wgs84.WGS84LonLat().To(wgs84.UTM(32, true))(9, 52, 0))
+	fmt.Println(east, north)
+	// Output:
+	// 9 52
+}
+
+func ExampleProjectedReferenceSystem_Contains() {
+	fmt.Println(wgs84.UTM(32, true).Contains(9, 52), wgs84.UTM(32, true).Contains(20, 52))
+	// Output:
+	// true false
+}
+
+func ExampleEPSG() {
+	epsg := wgs84.EPSG()
+	east, north, _ := epsg.Transform(4326, 25832).Round(2)(9, 52, 0)
+	fmt.Println(east, north)
+	// Output:
+	// 500000 5.76103821e+06
+}
+
+func ExampleRepository_SafeTransform() {
+	_, _, _, err := wgs84.EPSG().SafeTransform(4326, 27700)(9, 52, 0)
+	fmt.Println(err)
+	// Output:
+	// coordinate is out of bounds
+}
+
+func ExampleRepository_CodesCover() {
+	codes := wgs84.EPSG().CodesCover(-1, 52)
+	sort.Ints(codes)
+	fmt.Println(codes)
+	// Output:
+	// [3035 3413 3416 3857 4230 4258 4277 4326 4978 23030 25830 27700 32630 32662 900913]
+}
+
+func ExampleFromEPSG() {
+	crs, err := wgs84.FromEPSG(32632)
+	if err != nil {
+		panic(err)
+	}
+
+	east, north, h := wgs84.WGS84LonLat().To(crs).Round(2)(9, 52, 0)
+	fmt.Println(east, north, h)
+
+	_, err = wgs84.FromEPSG(1)
+	fmt.Println(errors.Is(err, wgs84.ErrUnknownEPSGCode), err)
+	// Output:
+	// 500000 5.76103821e+06 0
+	// true unknown EPSG-Code: 1
+}
+
+func ExampleMustEPSG() {
+	crs := wgs84.MustEPSG(3857)
+	east, north, _ := wgs84.To(crs).Round(2)(9, 52, 0)
+	fmt.Println(east, north)
+	// Output:
+	// 1.00187542e+06 6.80012545e+06
+}
+
+func ExampleFunc_Round() {
+	lon, lat, _ := wgs84.From(wgs84.WebMercator()).Round(3)(1001875.42, 6800125.45, 0)
+	fmt.Println(lon, lat)
+	// Output:
+	// 9 52
+}
+
+func ExampleOSGB36NationalGrid() {
+	east, north, _ := wgs84.To(wgs84.OSGB36NationalGrid()).Round(0)(-0.1276, 51.5072, 0)
+	fmt.Println(east, north)
+	// Output:
+	// 530043 180358
+}
+
+func ExampleDHDN2001GK() {
+	east, north, _ := wgs84.To(wgs84.DHDN2001GK(3)).Round(0)(9, 52, 0)
+	fmt.Println(east, north)
+	// Output:
+	// 3.500073e+06 5.762904e+06
+}
+
+func ExampleMGIAustriaLambert() {
+	east, north, _ := wgs84.To(wgs84.MGIAustriaLambert()).Round(0)(16.37, 48.21, 0)
+	fmt.Println(east, north)
+	// Output:
+	// 625633 483376
+}
+
+func ExampleRGF93FranceLambert() {
+	east, north, _ := wgs84.To(wgs84.RGF93FranceLambert()).Round(0)(2.35, 48.86, 0)
+	fmt.Println(east, north)
+	// Output:
+	// 652311 6.862415e+06
+}
+
+func ExampleNAD83AlabamaEast() {
+	east, north, _ := wgs84.To(wgs84.NAD83AlabamaEast()).Round(0)(-85.5, 32.5, 0)
+	fmt.Println(east, north)
+	// Output:
+	// 231324 221796
+}
+
+func ExampleHelmert() {
+	crs := wgs84.Helmert(wgs84.A, wgs84.Fi, 100, 0, 0, 0, 0, 0, 0).XYZ()
+	x, y, z := wgs84.WGS84XYZ().To(crs)(6378137, 0, 0)
+	fmt.Println(x, y, z)
+	// Output:
+	// 6.378037e+06 0 0
+}
+
+func ExampleDatum_TransverseMercator() {
+	crs := wgs84.ETRS89().TransverseMercator(15, 0, 0.9996, 500000, 0)
+	east, north, _ := wgs84.To(crs).Round(2)(15, 60, 0)
+	fmt.Println(east, north)
+	// Output:
+	// 500000 6.65141119e+06
+}
+
+func ExampleDistance() {
+	fmt.Printf("%.3f\n", wgs84.Distance(9, 52, 13.4, 52.5, wgs84.WGS84()))
+	// Output:
+	// 305553.018
+}
+
+func ExampleHaversineDistance() {
+	fmt.Printf("%.3f\n", wgs84.HaversineDistance(9, 52, 13.4, 52.5))
+	// Output:
+	// 304597.716
+}
+
+func ExampleDensify() {
+	for _, c := range wgs84.Densify(9, 52, 13.4, 52.5, 100000, wgs84.WGS84()) {
+		fmt.Printf("%.4f %.4f\n", c[0], c[1])
+	}
+	// Output:
+	// 9.0000 52.0000
+	// 10.0905 52.1403
+	// 11.1876 52.2705
+	// 12.2909 52.3904
+	// 13.4000 52.5000
+}
+
+func ExampleRhumbBearing() {
+	fmt.Printf("%.4f\n", wgs84.RhumbBearing(9, 52, 13.4, 52.5))
+	// Output:
+	// 79.5105
+}
+
+func ExampleAffineTransform() {
+	gt := [6]float64{500000, 10, 0, 5800000, 0, -10}
+	lon, lat, _ := wgs84.UTM(32, true).To(wgs84.WGS84LonLat()).Round(6)(wgs84.AffineTransform(gt)(0.5, 0.5, 0))
+	fmt.Println(lon, lat)
+	// Output:
+	// 9.000073 52.350248
+}
+
+func ExampleInverseAffineTransform() {
+	gt := [6]float64{500000, 10, 0, 5800000, 0, -10}
+	col, row, _ := wgs84.InverseAffineTransform(gt)(500105, 5799895, 0)
+	fmt.Println(col, row)
+	// Output:
+	// 10.5 10.5
+}
+
+func ExampleToProj4String() {
+	s, _ := wgs84.ToProj4String(wgs84.ETRS89UTM(32))
+	fmt.Println(s)
+	// Output:
+	// +proj=tmerc +lat_0=0 +lon_0=9 +k=0.9996 +x_0=500000 +y_0=0 +ellps=GRS80 +towgs84=0,0,0,0,0,0,0 +units=m +no_defs
+}
+
+func ExampleParsePROJ() {
+	crs, _ := wgs84.ParsePROJ("+proj=utm +zone=32 +ellps=GRS80 +units=m +no_defs")
+	east, north, _ := wgs84.To(crs).Round(2)(9, 52, 0)
+	fmt.Println(east, north)
+	// Output:
+	// 500000 5.76103821e+06
+}
+
+func ExampleFingerprint() {
+	a := wgs84.ETRS89UTM(32)
+	b := wgs84.ETRS89().TransverseMercator(9, 0, 0.9996, 500000, 0)
+	fmt.Println(wgs84.Fingerprint(a) == wgs84.Fingerprint(b), wgs84.Fingerprint(a) == wgs84.Fingerprint(wgs84.UTM(32, true)))
+	// Output:
+	// true false
+}
+
+func ExampleCRSMetadata() {
+	crs := wgs84.UTM(32, true)
+	fmt.Println(crs.EPSGCode(), crs.Name())
+	// Output:
+	// 32632 WGS 84 / UTM zone 32N
+}
+
+func ExampleAnnotateGeoJSON() {
+	geojson, err := wgs84.AnnotateGeoJSON([]byte(`{"type":"Point","coordinates":[500000,5761038.21]}`), wgs84.UTM(32, true))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(string(geojson))
+
+	crs, err := wgs84.ReadGeoJSONCRS(geojson)
+	if err != nil {
+		panic(err)
+	}
+
+	lon, lat, _ := wgs84.Transform(crs, wgs84.WGS84LonLat()).Round(3)(500000, 5761038.21, 0)
+	fmt.Println(lon, lat)
+	// Output:
+	// {"coordinates":[500000,5761038.21],"crs":{"type":"name","properties":{"name":"urn:ogc:def:crs:EPSG::32632"}},"type":"Point"}
+	// 9 52
+}
+
+func ExampleTransformNetwork() {
+	stations := [][3]float64{
+		{500000, 5761038.21, 0},
+		{500100, 5761038.21, 0},
+		{500100, 5761138.21, 0},
+	}
+
+	network, err := wgs84.TransformNetwork(wgs84.UTM(32, true), wgs84.ETRS89UTM(32), stations)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%.3f %.3f\n", network[1][0]-network[0][0], network[2][1]-network[1][1])
+	// Output:
+	// 100.000 100.000
+}
+
+func ExampleTransformSlice() {
+	coords := [][3]float64{{9, 52, 0}, {10, 53, 0}}
+	dst := make([][3]float64, 0, len(coords))
+
+	for _, c := range wgs84.TransformSlice(wgs84.WGS84LonLat(), wgs84.UTM(32, true), coords, dst) {
+		fmt.Printf("%.2f %.2f\n", c[0], c[1])
+	}
+
+	_, errs := wgs84.SafeTransformSlice(wgs84.WGS84LonLat(), wgs84.UTM(32, true), [][3]float64{{9, 52, 0}, {9, -52, 0}}, nil)
+	fmt.Println(errs)
+	// Output:
+	// 500000.00 5761038.21
+	// 567109.44 5872738.26
+	// [<nil> coordinate is out of bounds]
+}
+
+func ExampleTransformMany() {
+	points := func(yield func(x, y, z float64) bool) {
+		for _, c := range [][3]float64{{9, 52, 0}, {10, 53, 0}} {
+			if !yield(c[0], c[1], c[2]) {
+				return
+			}
+		}
+	}
+
+	// with Go 1.23: for east, north, _ := range wgs84.TransformMany(...)
+	wgs84.TransformMany(wgs84.WGS84LonLat(), wgs84.UTM(32, true), points)(func(east, north, _ float64) bool {
+		fmt.Printf("%.2f %.2f\n", east, north)
+
+		return true
+	})
+	// Output:
+	// 500000.00 5761038.21
+	// 567109.44 5872738.26
+}
+
+func ExampleTransformTyped() {
+	type city struct {
+		Name     string
+		Lon, Lat float64
+	}
+
+	toUTM := wgs84.TransformTyped(wgs84.WGS84LonLat(), wgs84.UTM(32, true),
+		func(c city) (float64, float64, float64) { return c.Lon, c.Lat, 0 },
+		func(c *city, east, north, _ float64) { c.Lon, c.Lat = east, north },
+	)
+
+	for _, c := range toUTM([]city{{"A", 9, 52}, {"B", 10, 53}}) {
+		fmt.Printf("%s %.2f %.2f\n", c.Name, c.Lon, c.Lat)
+	}
+	// Output:
+	// A 500000.00 5761038.21
+	// B 567109.44 5872738.26
+}
+
+func ExampleGroundDistance() {
+	fmt.Printf("%.2f\n", wgs84.GroundDistance(wgs84.UTM(32, true), 500000, 5761038.21, 1000))
+	// Output:
+	// 1000.40
+}
+
+func ExampleToPROJPipeline_timeDependent() {
+	pipeline, err := wgs84.ToPROJPipeline(wgs84.NAD83CORS96(2010).XYZ(), wgs84.WGS84XYZ())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(pipeline)
+	// Output:
+	// +proj=pipeline +step +proj=helmert +x=-0.9956 +y=1.9013 +z=0.5215 +rx=0.025915 +ry=0.009426 +rz=0.011599 +s=-0.00062 +dx=-0.0007 +dy=0.0007 +dz=-0.0005 +drx=0.000067 +dry=-0.000757 +drz=-0.000051 +ds=0.00018 +t_epoch=1997 +t_obs=2010 +convention=position_vector
+}
+
+func ExampleWKTWriter_empty() {
+	ww := wgs84.NewWKTWriter(os.Stdout, wgs84.WGS84LonLat())
+
+	_ = ww.StartLineString()
+	_ = ww.EndGeometry()
+	// Output:
+	// LINESTRING Z EMPTY
+}
+
+func ExampleParseNGSDatasheet() {
+	datasheet := ` DATABASE = ,PROGRAM = datasheet95, VERSION = 8.12.5.15
+1        National Geodetic Survey,   Retrieval Date = MAY 26, 2021
+ HV9395 ***********************************************************************
+ HV9395  DESIGNATION -  GPS 4
+ HV9395  PID         -  HV9395
+ HV9395  STATE/COUNTY-  VA/FAIRFAX
+ HV9395* NAD 83(2011) POSITION- 38 52 31.43261(N) 077 13 39.95125(W)   ADJUSTED
+ HV9395* NAD 83(2011) ELLIP HT-    47.874 (meters)        (06/27/12)   ADJUSTED
+ HV9395* NAVD 88 ORTHO HEIGHT -    79.58  (meters)     261.1  (feet) GPS OBS
+`
+
+	marks, err := wgs84.ParseNGSDatasheet(strings.NewReader(datasheet))
+	if err != nil {
+		panic(err)
+	}
+
+	for _, m := range marks {
+		fmt.Printf("%s %s %.6f %.6f %.3f %.2f\n", m.PID, m.Name, m.Lon, m.Lat, m.EllipsoidalHeight, m.OrthometricHeight)
+	}
+	// Output:
+	// HV9395 GPS 4 -77.227764 38.875398 47.874 79.58
+}
@@ -0,0 +1,99 @@
+package wgs84
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/wroge/wgs84/datum"
+)
+
+// Fingerprint returns a SHA-256 hash of the Datum and Projection parameters
+// of a CoordinateReferenceSystem.
+//
+// Coordinate Reference System's that are functionally identical produce the
+// same Fingerprint, even if they are constructed independently. Areas are
+// not part of the Fingerprint.
+//
+// Only the parameters of the types of this package are hashed. For unknown
+// CoordinateReferenceSystem's, Projections and Transformations the zero value
+// is returned.
+func Fingerprint(crs CoordinateReferenceSystem) [32]byte {
+	h := sha256.New()
+
+	var ok bool
+
+	switch c := crs.(type) {
+	case GeocentricReferenceSystem:
+		fmt.Fprint(h, "geocentric;")
+		ok = fingerprintDatum(h, c.Datum)
+	case GeographicReferenceSystem:
+		fmt.Fprint(h, "geographic;")
+		ok = fingerprintDatum(h, c.Datum)
+	case Geographic3DCRS:
+		fmt.Fprint(h, "geographic;")
+		ok = fingerprintDatum(h, c.Datum)
+	case Geographic2DCRS:
+		fmt.Fprint(h, "geographic2d;")
+		ok = fingerprintDatum(h, c.Datum)
+	case ProjectedReferenceSystem:
+		fmt.Fprint(h, "projected;")
+		ok = fingerprintDatum(h, c.Datum) && fingerprintProjection(h, c.Projection)
+	}
+
+	var sum [32]byte
+
+	if ok {
+		copy(sum[:], h.Sum(nil))
+	}
+
+	return sum
+}
+
+func fingerprintDatum(w io.Writer, d Datum) bool {
+	fmt.Fprintf(w, "body=%s;a=%.17g;fi=%.17g;", d.Body, d.A(), d.Fi())
+
+	switch t := d.Transformation.(type) {
+	case nil:
+	case helmert:
+		if t != (helmert{}) {
+			fingerprintHelmert(w, "helmert", t)
+		}
+	case datum.TimeDependentHelmert:
+		fingerprintHelmert(w, "helmert", t.Helmert)
+		fingerprintHelmert(w, "rates", t.Rates)
+		fmt.Fprintf(w, "epochs=%.17g,%.17g;", t.RefEpoch, t.Epoch)
+	default:
+		return false
+	}
+
+	return true
+}
+
+func fingerprintHelmert(w io.Writer, name string, t helmert) {
+	fmt.Fprintf(w, "%s=%.17g,%.17g,%.17g,%.17g,%.17g,%.17g,%.17g;", name, t.Tx, t.Ty, t.Tz, t.Rx, t.Ry, t.Rz, t.Ds)
+}
+
+func fingerprintProjection(w io.Writer, p Projection) bool {
+	method, params, err := ProjectionParameters(p)
+	if err != nil {
+		return false
+	}
+
+	keys := make([]string, 0, len(params))
+
+	for k := range params {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	fmt.Fprintf(w, "%s;", method)
+
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s=%.17g;", k, params[k])
+	}
+
+	return true
+}
@@ -0,0 +1,41 @@
+package wgs84_test
+
+import (
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+type customProjection struct {
+	scale *float64
+}
+
+func (p customProjection) ToLonLat(east, north float64, _ wgs84.Spheroid) (lon, lat float64) {
+	return east / *p.scale, north / *p.scale
+}
+
+func (p customProjection) FromLonLat(lon, lat float64, _ wgs84.Spheroid) (east, north float64) {
+	return lon * *p.scale, lat * *p.scale
+}
+
+func TestFingerprintUnknown(t *testing.T) {
+	t.Parallel()
+
+	scale := 1.0
+	crs := wgs84.ProjectedReferenceSystem{
+		Datum:      wgs84.WGS84(),
+		Projection: customProjection{scale: &scale},
+	}
+
+	if wgs84.Fingerprint(crs) != [32]byte{} || wgs84.Fingerprint(nil) != [32]byte{} {
+		t.Fatal("unknown types must have no fingerprint")
+	}
+
+	if wgs84.Fingerprint(wgs84.WGS84().LonLat()) != wgs84.Fingerprint(wgs84.WGS84LonLat()) {
+		t.Fatal("fingerprint depends on metadata")
+	}
+
+	if wgs84.Fingerprint(wgs84.MarsGeographic()) == wgs84.Fingerprint(wgs84.WGS84().LonLat()) {
+		t.Fatal("fingerprint ignores the body")
+	}
+}
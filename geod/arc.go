@@ -0,0 +1,52 @@
+package geod
+
+import "math"
+
+// MeridianArc returns the distance in meters on the meridian from the equator
+// to a latitude.
+func (e Ellipsoid) MeridianArc(lat float64) float64 {
+	φ := radian(lat)
+	e2 := e.E2()
+	e4 := e2 * e2
+	e6 := e4 * e2
+
+	return e.A * ((1-e2/4-3*e4/64-5*e6/256)*φ -
+		(3*e2/8+3*e4/32+45*e6/1024)*math.Sin(2*φ) +
+		(15*e4/256+45*e6/1024)*math.Sin(4*φ) -
+		(35*e6/3072)*math.Sin(6*φ))
+}
+
+// InverseMeridianArc returns the latitude reached after m meters on the
+// meridian from the equator.
+func (e Ellipsoid) InverseMeridianArc(m float64) float64 {
+	φ := m / e.A
+
+	for i := 0; i < 10; i++ {
+		w := 1 - e.E2()*sin2(φ)
+		Δφ := (m - e.MeridianArc(degree(φ))) / (e.A * (1 - e.E2()) / math.Pow(w, 1.5))
+		φ += Δφ
+
+		if math.Abs(Δφ) < 1e-14 {
+			break
+		}
+	}
+
+	return degree(φ)
+}
+
+// IsometricLatitude returns the isometric latitude, also known as Mercator
+// latitude, of a latitude.
+func (e Ellipsoid) IsometricLatitude(lat float64) float64 {
+	sinφ := math.Sin(radian(lat))
+
+	return degree(math.Atanh(sinφ) - e.ecc()*math.Atanh(e.ecc()*sinφ))
+}
+
+// HaversineDistance returns the great circle distance in meters between two
+// geographic coordinates on a sphere with the MeanRadius.
+func HaversineDistance(lon1, lat1, lon2, lat2 float64) float64 {
+	φ1, φ2 := radian(lat1), radian(lat2)
+	h := sin2((φ2-φ1)/2) + math.Cos(φ1)*math.Cos(φ2)*sin2(radian(lon2-lon1)/2)
+
+	return 2 * MeanRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
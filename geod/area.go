@@ -0,0 +1,81 @@
+package geod
+
+import "math"
+
+// Area returns the area in square meters of a polygon ring of geographic
+// coordinates with geodesic edges.
+//
+// The ring is closed implicitly and can be oriented in both directions. The
+// area is computed on the authalic sphere, which has the surface of the
+// ellipsoid and preserves the area of latitude bands.
+func (e Ellipsoid) Area(ring [][2]float64) float64 {
+	if len(ring) < 3 {
+		return 0
+	}
+
+	var excess float64
+
+	for i := range ring {
+		c1, c2 := ring[i], ring[(i+1)%len(ring)]
+		t1 := math.Tan(e.authalicLatitude(c1[1]) / 2)
+		t2 := math.Tan(e.authalicLatitude(c2[1]) / 2)
+		t := math.Tan(radian(normalizeLon(c2[0]-c1[0])) / 2)
+		excess += 2 * math.Atan(t*(t1+t2)/(1+t1*t2))
+	}
+
+	qp := e.q(math.Pi / 2)
+
+	return math.Abs(excess) * e.A * e.A * qp / 2
+}
+
+// Centroid returns the geographic coordinate on the ellipsoid below the mean
+// of the geocentric coordinates of the geographic coordinates.
+//
+// Returns NaN if there are no coordinates or the mean is the center of the
+// ellipsoid.
+func (e Ellipsoid) Centroid(coords [][2]float64) (lon, lat float64) {
+	var x, y, z float64
+
+	for _, c := range coords {
+		cx, cy, cz := e.toXYZ(c[0], c[1])
+		x += cx
+		y += cy
+		z += cz
+	}
+
+	if x == 0 && y == 0 && z == 0 {
+		return math.NaN(), math.NaN()
+	}
+
+	p := math.Hypot(x, y)
+	φ := math.Atan2(z, p*(1-e.E2()))
+
+	for i := 0; i < 10; i++ {
+		n := e.A / math.Sqrt(1-e.E2()*sin2(φ))
+		φ = math.Atan2(z+e.E2()*n*math.Sin(φ), p)
+	}
+
+	return degree(math.Atan2(y, x)), degree(φ)
+}
+
+func (e Ellipsoid) toXYZ(lon, lat float64) (x, y, z float64) {
+	φ, λ := radian(lat), radian(lon)
+	n := e.A / math.Sqrt(1-e.E2()*sin2(φ))
+
+	return n * math.Cos(φ) * math.Cos(λ), n * math.Cos(φ) * math.Sin(λ), n * (1 - e.E2()) * math.Sin(φ)
+}
+
+func (e Ellipsoid) authalicLatitude(lat float64) float64 {
+	return math.Asin(e.q(radian(lat)) / e.q(math.Pi/2))
+}
+
+func (e Ellipsoid) q(φ float64) float64 {
+	ecc := e.ecc()
+	sinφ := math.Sin(φ)
+
+	if ecc == 0 {
+		return 2 * sinφ
+	}
+
+	return (1 - e.E2()) * (sinφ/(1-e.E2()*sinφ*sinφ) - 1/(2*ecc)*math.Log((1-ecc*sinφ)/(1+ecc*sinφ)))
+}
@@ -0,0 +1,27 @@
+package geod_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84/geod"
+)
+
+func TestAreaSphere(t *testing.T) {
+	t.Parallel()
+
+	const r = 1737400
+
+	sphere := geod.Ellipsoid{A: r, Fi: math.Inf(1)}
+	octant := [][2]float64{{0, 0}, {90, 0}, {0, 90}}
+
+	area := sphere.Area(octant)
+	if want := math.Pi * r * r / 2; math.Abs(area-want) > 1e-6*want {
+		t.Fatal(area, want)
+	}
+
+	lon, lat := sphere.Centroid(octant)
+	if math.IsNaN(lon) || math.IsNaN(lat) {
+		t.Fatal(lon, lat)
+	}
+}
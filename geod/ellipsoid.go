@@ -0,0 +1,57 @@
+// Package geod provides geodetic computations on an ellipsoid of revolution.
+//
+// It doesn't depend on the Coordinate Reference Systems of the wgs84 package,
+// which uses it internally for geodesics, meridian arcs and areas.
+// Geographic coordinates and azimuths are in degrees, distances in meters.
+package geod
+
+import "math"
+
+// MeanRadius is the mean earth radius in meters.
+const MeanRadius = 6371008.8
+
+// Ellipsoid is an ellipsoid of revolution with the semi-major axis A in meters
+// and the inverse flattening Fi.
+type Ellipsoid struct {
+	A, Fi float64
+}
+
+// WGS84 returns the WGS84 ellipsoid.
+func WGS84() Ellipsoid {
+	return Ellipsoid{A: 6378137, Fi: 298.257223563}
+}
+
+// F returns the flattening of the ellipsoid.
+func (e Ellipsoid) F() float64 {
+	return 1 / e.Fi
+}
+
+// B returns the semi-minor axis of the ellipsoid.
+func (e Ellipsoid) B() float64 {
+	return e.A * (1 - e.F())
+}
+
+// E2 returns the squared first eccentricity of the ellipsoid.
+func (e Ellipsoid) E2() float64 {
+	return 2*e.F() - e.F()*e.F()
+}
+
+func (e Ellipsoid) ecc() float64 {
+	return math.Sqrt(e.E2())
+}
+
+func radian(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+func degree(r float64) float64 {
+	return r * 180 / math.Pi
+}
+
+func sin2(x float64) float64 {
+	return math.Pow(math.Sin(x), 2)
+}
+
+func normalizeLon(lon float64) float64 {
+	return math.Mod(lon+540, 360) - 180
+}
@@ -0,0 +1,111 @@
+package geod
+
+import "math"
+
+// Inverse returns the distance in meters and the forward azimuths in
+// degrees at both points of the geodesic between two geographic coordinates.
+//
+// It uses the iterative formulae of Vincenty.
+func (e Ellipsoid) Inverse(lon1, lat1, lon2, lat2 float64) (dist, az1, az2 float64) {
+	f := e.F()
+	b := e.B()
+	L := radian(lon2 - lon1)
+	U1 := math.Atan((1 - f) * math.Tan(radian(lat1)))
+	U2 := math.Atan((1 - f) * math.Tan(radian(lat2)))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+
+	var sinσ, cosσ, σ, cos2α, cos2σm float64
+
+	λ := L
+
+	for i := 0; i < 200; i++ {
+		sinλ, cosλ := math.Sincos(λ)
+		sinσ = math.Hypot(cosU2*sinλ, cosU1*sinU2-sinU1*cosU2*cosλ)
+
+		if sinσ == 0 {
+			return 0, 0, 0
+		}
+
+		cosσ = sinU1*sinU2 + cosU1*cosU2*cosλ
+		σ = math.Atan2(sinσ, cosσ)
+		sinα := cosU1 * cosU2 * sinλ / sinσ
+		cos2α = 1 - sinα*sinα
+
+		cos2σm = 0
+		if cos2α != 0 {
+			cos2σm = cosσ - 2*sinU1*sinU2/cos2α
+		}
+
+		C := f / 16 * cos2α * (4 + f*(4-3*cos2α))
+		λi := λ
+		λ = L + (1-C)*f*sinα*(σ+C*sinσ*(cos2σm+C*cosσ*(-1+2*cos2σm*cos2σm)))
+
+		if math.Abs(λ-λi) < 1e-12 {
+			break
+		}
+	}
+
+	u2 := cos2α * (e.A*e.A - b*b) / (b * b)
+	A := 1 + u2/16384*(4096+u2*(-768+u2*(320-175*u2)))
+	B := u2 / 1024 * (256 + u2*(-128+u2*(74-47*u2)))
+	Δσ := B * sinσ * (cos2σm + B/4*(cosσ*(-1+2*cos2σm*cos2σm)-
+		B/6*cos2σm*(-3+4*sinσ*sinσ)*(-3+4*cos2σm*cos2σm)))
+
+	sinλ, cosλ := math.Sincos(λ)
+	dist = b * A * (σ - Δσ)
+	az1 = degree(math.Atan2(cosU2*sinλ, cosU1*sinU2-sinU1*cosU2*cosλ))
+	az2 = degree(math.Atan2(cosU1*sinλ, -sinU1*cosU2+cosU1*sinU2*cosλ))
+
+	return dist, az1, az2
+}
+
+// Direct returns the geographic coordinate and the forward azimuth in
+// degrees reached from a geographic coordinate after dist meters on the
+// geodesic with the azimuth az.
+//
+// It uses the iterative formulae of Vincenty.
+func (e Ellipsoid) Direct(lon, lat, az, dist float64) (lon2, lat2, az2 float64) {
+	f := e.F()
+	b := e.B()
+	sinα1, cosα1 := math.Sincos(radian(az))
+	tanU1 := (1 - f) * math.Tan(radian(lat))
+	cosU1 := 1 / math.Sqrt(1+tanU1*tanU1)
+	sinU1 := tanU1 * cosU1
+	σ1 := math.Atan2(tanU1, cosα1)
+	sinα := cosU1 * sinα1
+	cos2α := 1 - sinα*sinα
+	u2 := cos2α * (e.A*e.A - b*b) / (b * b)
+	A := 1 + u2/16384*(4096+u2*(-768+u2*(320-175*u2)))
+	B := u2 / 1024 * (256 + u2*(-128+u2*(74-47*u2)))
+
+	var sinσ, cosσ, cos2σm float64
+
+	σ := dist / (b * A)
+
+	for i := 0; i < 200; i++ {
+		cos2σm = math.Cos(2*σ1 + σ)
+		sinσ, cosσ = math.Sincos(σ)
+		Δσ := B * sinσ * (cos2σm + B/4*(cosσ*(-1+2*cos2σm*cos2σm)-
+			B/6*cos2σm*(-3+4*sinσ*sinσ)*(-3+4*cos2σm*cos2σm)))
+		σi := σ
+		σ = dist/(b*A) + Δσ
+
+		if math.Abs(σ-σi) < 1e-12 {
+			break
+		}
+	}
+
+	sinσ, cosσ = math.Sincos(σ)
+	cos2σm = math.Cos(2*σ1 + σ)
+	x := sinU1*sinσ - cosU1*cosσ*cosα1
+	φ := math.Atan2(sinU1*cosσ+cosU1*sinσ*cosα1, (1-f)*math.Hypot(sinα, x))
+	λ := math.Atan2(sinσ*sinα1, cosU1*cosσ-sinU1*sinσ*cosα1)
+	C := f / 16 * cos2α * (4 + f*(4-3*cos2α))
+	L := λ - (1-C)*f*sinα*(σ+C*sinσ*(cos2σm+C*cosσ*(-1+2*cos2σm*cos2σm)))
+
+	lon2 = normalizeLon(lon + degree(L))
+	az2 = degree(math.Atan2(sinα, -x))
+
+	return lon2, degree(φ), az2
+}
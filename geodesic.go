@@ -0,0 +1,329 @@
+package wgs84
+
+import (
+	"errors"
+	"math"
+
+	"github.com/wroge/wgs84/geod"
+)
+
+var (
+	// ErrNoIntersection is returned for parallel or coincident geodesics.
+	ErrNoIntersection = errors.New("geodesics do not intersect")
+	// ErrNoConvergence is returned if an iterative refinement doesn't
+	// converge.
+	ErrNoConvergence = errors.New("refinement does not converge")
+)
+
+// Densify inserts intermediate points on the geodesic between two geographic
+// coordinates of a Datum, so that each segment is no longer than
+// maxSegmentMeters.
+//
+// The result includes both endpoints. This prevents straight line distortion
+// when the line is transformed to another CoordinateReferenceSystem.
+func Densify(lon1, lat1, lon2, lat2 float64, maxSegmentMeters float64, d Datum) [][2]float64 {
+	sph := spheroid{a: d.A(), fi: d.Fi()}
+	dist, az, _ := vincentyInverse(lon1, lat1, lon2, lat2, sph)
+
+	n := 1
+	if maxSegmentMeters > 0 {
+		n = int(math.Ceil(dist / maxSegmentMeters))
+	}
+
+	if n < 1 {
+		n = 1
+	}
+
+	coords := make([][2]float64, 0, n+1)
+	coords = append(coords, [2]float64{lon1, lat1})
+
+	for i := 1; i < n; i++ {
+		lon, lat, _ := vincentyDirect(lon1, lat1, az, dist*float64(i)/float64(n), sph)
+		coords = append(coords, [2]float64{lon, lat})
+	}
+
+	return append(coords, [2]float64{lon2, lat2})
+}
+
+// Distance returns the length in meters of the geodesic between two
+// geographic coordinates of a Datum.
+//
+// It is computed with Vincenty's inverse formula.
+func Distance(lon1, lat1, lon2, lat2 float64, d Datum) float64 {
+	dist, _, _ := vincentyInverse(lon1, lat1, lon2, lat2, spheroid{a: d.A(), fi: d.Fi()})
+
+	return dist
+}
+
+// HaversineDistance returns the great circle distance in meters between two
+// geographic coordinates on a sphere with the mean earth radius of
+// 6371008.8 meters.
+//
+// It is a fast approximation for navigation or user interfaces. Since the
+// earth is not a sphere, the error relative to Distance is up to 0.5%.
+func HaversineDistance(lon1, lat1, lon2, lat2 float64) float64 {
+	return geod.HaversineDistance(lon1, lat1, lon2, lat2)
+}
+
+// DensifyPolyline inserts intermediate points on the geodesics between the
+// geographic coordinates of a polyline, so that each segment is no longer than
+// maxSegmentMeters.
+func DensifyPolyline(coords [][2]float64, maxSegmentMeters float64, d Datum) [][2]float64 {
+	if len(coords) < 2 {
+		return append([][2]float64(nil), coords...)
+	}
+
+	result := [][2]float64{coords[0]}
+
+	for i := 1; i < len(coords); i++ {
+		segment := Densify(coords[i-1][0], coords[i-1][1], coords[i][0], coords[i][1], maxSegmentMeters, d)
+		result = append(result, segment[1:]...)
+	}
+
+	return result
+}
+
+// SimplifyPolyline removes points of a polyline in geographic coordinates of a
+// Datum with the Ramer-Douglas-Peucker algorithm.
+//
+// The distances of the points to the geodesics between the remaining points
+// are computed on the spheroid and compared to toleranceMeters.
+func SimplifyPolyline(coords [][2]float64, toleranceMeters float64, d Datum) [][2]float64 {
+	if len(coords) < 3 {
+		return append([][2]float64(nil), coords...)
+	}
+
+	sph := spheroid{a: d.A(), fi: d.Fi()}
+	keep := make([]bool, len(coords))
+	keep[0], keep[len(coords)-1] = true, true
+
+	var simplify func(first, last int)
+
+	simplify = func(first, last int) {
+		index, maxDist := -1, toleranceMeters
+
+		for i := first + 1; i < last; i++ {
+			dist, _, _ := closestOnGeodesic(coords[i][0], coords[i][1],
+				coords[first][0], coords[first][1], coords[last][0], coords[last][1], sph)
+			if dist > maxDist {
+				index, maxDist = i, dist
+			}
+		}
+
+		if index < 0 {
+			return
+		}
+
+		keep[index] = true
+
+		simplify(first, index)
+		simplify(index, last)
+	}
+
+	simplify(0, len(coords)-1)
+
+	result := make([][2]float64, 0, len(coords))
+
+	for i, c := range coords {
+		if keep[i] {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// GeodesicIntersection returns the intersection of two geodesics on a Datum,
+// each defined by a geographic coordinate and an azimuth in degrees.
+//
+// The intersection is first computed on a sphere and refined on the spheroid.
+// Of the two intersections the one closer to the given coordinates is
+// returned. Returns ErrNoIntersection if the geodesics are parallel or
+// coincident and ErrNoConvergence if the refinement doesn't converge.
+func GeodesicIntersection(lon1, lat1, az1, lon2, lat2, az2 float64, d Datum) (lon, lat float64, err error) {
+	sph := spheroid{a: d.A(), fi: d.Fi()}
+	p1, n1 := greatCircle(lon1, lat1, az1)
+	p2, n2 := greatCircle(lon2, lat2, az2)
+	i := cross(n1, n2)
+
+	l := math.Sqrt(dot(i, i))
+	if l < 1e-12 {
+		return 0, 0, ErrNoIntersection
+	}
+
+	i = [3]float64{i[0] / l, i[1] / l, i[2] / l}
+	if math.Acos(dot(p1, i))+math.Acos(dot(p2, i)) > math.Pi {
+		i = [3]float64{-i[0], -i[1], -i[2]}
+	}
+
+	r := (2*sph.A() + sph.b()) / 3
+	s1 := math.Atan2(dot(cross(p1, i), n1), dot(p1, i)) * r
+	s2 := math.Atan2(dot(cross(p2, i), n2), dot(p2, i)) * r
+
+	offset := func(s1, s2 float64) (dx, dy float64) {
+		lonA, latA, _ := vincentyDirect(lon1, lat1, az1, s1, sph)
+		lonB, latB, _ := vincentyDirect(lon2, lat2, az2, s2, sph)
+		dlon := math.Mod(lonB-lonA+540, 360) - 180
+
+		return radian(dlon) * r * math.Cos(radian(latA)), radian(latB-latA) * r
+	}
+
+	for n := 0; ; n++ {
+		fx, fy := offset(s1, s2)
+		if math.Hypot(fx, fy) < 1e-6 {
+			break
+		}
+
+		if n == 20 {
+			return 0, 0, ErrNoConvergence
+		}
+
+		ax, ay := offset(s1+1, s2)
+		bx, by := offset(s1, s2+1)
+		j11, j12, j21, j22 := ax-fx, bx-fx, ay-fy, by-fy
+
+		det := j11*j22 - j12*j21
+		if det == 0 {
+			return 0, 0, ErrNoIntersection
+		}
+
+		s1 -= (j22*fx - j12*fy) / det
+		s2 -= (j11*fy - j21*fx) / det
+	}
+
+	lon, lat, _ = vincentyDirect(lon1, lat1, az1, s1, sph)
+
+	return lon, lat, nil
+}
+
+// ClosestPoints returns the closest points of two geodesic segments on a
+// Datum and the distance between them in meters.
+//
+// If the segments intersect, both points are the intersection and the
+// distance is zero.
+func ClosestPoints(lon1, lat1, lon2, lat2, lon3, lat3, lon4, lat4 float64,
+	d Datum,
+) (cLon1, cLat1, cLon2, cLat2 float64, dist float64) {
+	sph := spheroid{a: d.A(), fi: d.Fi()}
+	d12, az12, _ := vincentyInverse(lon1, lat1, lon2, lat2, sph)
+	d34, az34, _ := vincentyInverse(lon3, lat3, lon4, lat4, sph)
+
+	if lon, lat, err := GeodesicIntersection(lon1, lat1, az12, lon3, lat3, az34, d); err == nil &&
+		onSegment(lon, lat, lon1, lat1, lon2, lat2, d12, sph) &&
+		onSegment(lon, lat, lon3, lat3, lon4, lat4, d34, sph) {
+		return lon, lat, lon, lat, 0
+	}
+
+	dist = math.Inf(1)
+
+	for i, c := range [4][6]float64{
+		{lon1, lat1, lon3, lat3, lon4, lat4},
+		{lon2, lat2, lon3, lat3, lon4, lat4},
+		{lon3, lat3, lon1, lat1, lon2, lat2},
+		{lon4, lat4, lon1, lat1, lon2, lat2},
+	} {
+		dc, lon, lat := closestOnGeodesic(c[0], c[1], c[2], c[3], c[4], c[5], sph)
+		if dc >= dist {
+			continue
+		}
+
+		dist = dc
+
+		if i < 2 {
+			cLon1, cLat1, cLon2, cLat2 = c[0], c[1], lon, lat
+		} else {
+			cLon1, cLat1, cLon2, cLat2 = lon, lat, c[0], c[1]
+		}
+	}
+
+	return cLon1, cLat1, cLon2, cLat2, dist
+}
+
+func onSegment(lon, lat, lon1, lat1, lon2, lat2, length float64, s spheroid) bool {
+	d1, _, _ := vincentyInverse(lon1, lat1, lon, lat, s)
+	d2, _, _ := vincentyInverse(lon, lat, lon2, lat2, s)
+
+	return d1+d2-length < 1e-3
+}
+
+// CrossTrackDistance returns the distance in meters of a geographic
+// coordinate to a geodesic segment on a Datum and the foot point on that
+// segment.
+//
+// If the perpendicular foot point lies outside of the segment, the closer
+// endpoint is returned.
+func CrossTrackDistance(ptLon, ptLat, lineLon1, lineLat1, lineLon2, lineLat2 float64,
+	d Datum,
+) (distance float64, footLon, footLat float64) {
+	return closestOnGeodesic(ptLon, ptLat, lineLon1, lineLat1, lineLon2, lineLat2, spheroid{a: d.A(), fi: d.Fi()})
+}
+
+// greatCircle returns the unit vector of a geographic coordinate on a sphere
+// and the normal of the great circle through it with the azimuth az.
+func greatCircle(lon, lat, az float64) (p, n [3]float64) {
+	sinφ, cosφ := math.Sincos(radian(lat))
+	sinλ, cosλ := math.Sincos(radian(lon))
+	sinθ, cosθ := math.Sincos(radian(az))
+
+	p = [3]float64{cosφ * cosλ, cosφ * sinλ, sinφ}
+	n = [3]float64{sinλ*cosθ - sinφ*cosλ*sinθ, -cosλ*cosθ - sinφ*sinλ*sinθ, cosφ * sinθ}
+
+	return p, n
+}
+
+// closestOnGeodesic returns the distance in meters of a geographic coordinate
+// to the geodesic segment between two geographic coordinates and the closest
+// point on that segment.
+//
+// The along-track position of the closest point is approximated on a sphere
+// with the mean radius of the spheroid and refined on the spheroid, until the
+// geodesic to the coordinate is perpendicular to the segment.
+func closestOnGeodesic(lon, lat, lon1, lat1, lon2, lat2 float64, s spheroid) (dist, footLon, footLat float64) {
+	d12, az12, _ := vincentyInverse(lon1, lat1, lon2, lat2, s)
+	d13, az13, _ := vincentyInverse(lon1, lat1, lon, lat, s)
+	r := (2*s.A() + s.b()) / 3
+	δ13 := d13 / r
+	along := math.Atan2(math.Sin(δ13)*math.Cos(radian(az13-az12)), math.Cos(δ13)) * r
+
+	for i := 0; i < 10 && along > 0 && along < d12; i++ {
+		fLon, fLat, azLine := vincentyDirect(lon1, lat1, az12, along, s)
+
+		dFoot, azFoot, _ := vincentyInverse(fLon, fLat, lon, lat, s)
+		if dFoot == 0 {
+			break
+		}
+
+		step := dFoot * math.Cos(radian(azFoot-azLine))
+		along += step
+
+		if math.Abs(step) < 1e-6 {
+			break
+		}
+	}
+
+	switch {
+	case along <= 0 || d12 == 0:
+		return d13, lon1, lat1
+	case along >= d12:
+		footLon, footLat = lon2, lat2
+	default:
+		footLon, footLat, _ = vincentyDirect(lon1, lat1, az12, along, s)
+	}
+
+	dist, _, _ = vincentyInverse(footLon, footLat, lon, lat, s)
+
+	return dist, footLon, footLat
+}
+
+// vincentyInverse returns the distance in meters and the forward azimuths in
+// degrees at both points of the geodesic between two geographic coordinates.
+func vincentyInverse(lon1, lat1, lon2, lat2 float64, s spheroid) (dist, az1, az2 float64) {
+	return s.ellipsoid().Inverse(lon1, lat1, lon2, lat2)
+}
+
+// vincentyDirect returns the geographic coordinate and the forward azimuth in
+// degrees reached from a geographic coordinate after dist meters on the
+// geodesic with the azimuth az.
+func vincentyDirect(lon, lat, az, dist float64, s spheroid) (lon2, lat2, az2 float64) {
+	return s.ellipsoid().Direct(lon, lat, az, dist)
+}
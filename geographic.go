@@ -0,0 +1,76 @@
+package wgs84
+
+// Geographic3DCRS represents a geographic Coordinate Reference System with
+// longitude, latitude and ellipsoidal height.
+//
+// The height is the distance in meters above the Spheroid of the Datum along
+// its normal. It behaves like the GeographicReferenceSystem, which carries the
+// height implicitly.
+type Geographic3DCRS struct {
+	GeographicReferenceSystem
+}
+
+// To provides the transformation to another CoordinateReferenceSystem.
+func (crs Geographic3DCRS) To(to CoordinateReferenceSystem) Func {
+	return Transform(crs, to)
+}
+
+// SafeTo provides the transformation to another CoordinateReferenceSystem
+// with errors.
+func (crs Geographic3DCRS) SafeTo(to CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(crs, to)
+}
+
+// From provides the transformation from another CoordinateReferenceSystem.
+func (crs Geographic3DCRS) From(from CoordinateReferenceSystem) Func {
+	return Transform(from, crs)
+}
+
+// SafeFrom provides the transformation from another CoordinateReferenceSystem
+// with errors.
+func (crs Geographic3DCRS) SafeFrom(from CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(from, crs)
+}
+
+// Geographic2DCRS represents a geographic Coordinate Reference System with
+// longitude and latitude only, like a geographic 2D CRS of ISO 19111.
+//
+// The height is ignored. Coordinates are placed on the Spheroid of the Datum
+// and the returned height is always 0.
+type Geographic2DCRS struct {
+	GeographicReferenceSystem
+}
+
+// ToWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs Geographic2DCRS) ToWGS84(lon, lat, _ float64) (x0, y0, z0 float64) {
+	return crs.GeographicReferenceSystem.ToWGS84(lon, lat, 0)
+}
+
+// FromWGS84 method is one method of the CoordinateReferenceSystem interface.
+func (crs Geographic2DCRS) FromWGS84(x0, y0, z0 float64) (lon, lat, h float64) {
+	lon, lat, _ = crs.GeographicReferenceSystem.FromWGS84(x0, y0, z0)
+
+	return lon, lat, 0
+}
+
+// To provides the transformation to another CoordinateReferenceSystem.
+func (crs Geographic2DCRS) To(to CoordinateReferenceSystem) Func {
+	return Transform(crs, to)
+}
+
+// SafeTo provides the transformation to another CoordinateReferenceSystem
+// with errors.
+func (crs Geographic2DCRS) SafeTo(to CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(crs, to)
+}
+
+// From provides the transformation from another CoordinateReferenceSystem.
+func (crs Geographic2DCRS) From(from CoordinateReferenceSystem) Func {
+	return Transform(from, crs)
+}
+
+// SafeFrom provides the transformation from another CoordinateReferenceSystem
+// with errors.
+func (crs Geographic2DCRS) SafeFrom(from CoordinateReferenceSystem) SafeFunc {
+	return SafeTransform(from, crs)
+}
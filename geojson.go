@@ -0,0 +1,110 @@
+package wgs84
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrNoEPSGCode is returned by AnnotateGeoJSON for
+	// CoordinateReferenceSystem's without EPSG-Code.
+	ErrNoEPSGCode = errors.New("no EPSG-Code")
+	// ErrInvalidGeoJSONCRS is returned by ReadGeoJSONCRS for crs members that
+	// are not named EPSG-Codes.
+	ErrInvalidGeoJSONCRS = errors.New("invalid GeoJSON crs member")
+)
+
+type geojsonCRS struct {
+	Type       string `json:"type"`
+	Properties struct {
+		Name string `json:"name"`
+	} `json:"properties"`
+}
+
+// AnnotateGeoJSON adds the crs member of the GeoJSON specification from 2008
+// to a GeoJSON object, like
+//
+//	"crs":{"type":"name","properties":{"name":"urn:ogc:def:crs:EPSG::32632"}}
+//
+// RFC 7946 removed the crs member, but many older readers still rely on it.
+// An existing crs member is replaced. The crs must have an EPSG-Code.
+func AnnotateGeoJSON(geojson []byte, crs CoordinateReferenceSystem) ([]byte, error) {
+	code := epsgCode(crs)
+	if code <= 0 {
+		return nil, ErrNoEPSGCode
+	}
+
+	var object map[string]json.RawMessage
+
+	if err := json.Unmarshal(geojson, &object); err != nil {
+		return nil, err
+	}
+
+	var member geojsonCRS
+
+	member.Type = "name"
+	member.Properties.Name = "urn:ogc:def:crs:EPSG::" + strconv.Itoa(code)
+
+	raw, err := json.Marshal(member)
+	if err != nil {
+		return nil, err
+	}
+
+	object["crs"] = raw
+
+	return json.Marshal(object)
+}
+
+// ReadGeoJSONCRS returns the CoordinateReferenceSystem of the crs member of a
+// GeoJSON object as written by AnnotateGeoJSON.
+//
+// Names like "urn:ogc:def:crs:EPSG::32632" and "EPSG:32632" are looked up in
+// the EPSG Repository. Without crs member the default of the GeoJSON
+// specification, WGS84LonLat, is returned.
+func ReadGeoJSONCRS(geojson []byte) (CoordinateReferenceSystem, error) {
+	var object struct {
+		CRS *geojsonCRS `json:"crs"`
+	}
+
+	if err := json.Unmarshal(geojson, &object); err != nil {
+		return nil, err
+	}
+
+	if object.CRS == nil {
+		return WGS84LonLat(), nil
+	}
+
+	if object.CRS.Type != "name" {
+		return nil, fmt.Errorf("%w: type %q", ErrInvalidGeoJSONCRS, object.CRS.Type)
+	}
+
+	name := object.CRS.Properties.Name
+
+	switch strings.ToUpper(name) {
+	case "URN:OGC:DEF:CRS:OGC:1.3:CRS84", "URN:OGC:DEF:CRS:OGC::CRS84":
+		return WGS84LonLat(), nil
+	}
+
+	i := strings.LastIndex(name, ":")
+	if i < 0 || !strings.Contains(strings.ToUpper(name[:i]), "EPSG") {
+		return nil, fmt.Errorf("%w: name %q", ErrInvalidGeoJSONCRS, name)
+	}
+
+	code, err := strconv.Atoi(name[i+1:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: name %q", ErrInvalidGeoJSONCRS, name)
+	}
+
+	return FromEPSG(code)
+}
+
+func epsgCode(crs CoordinateReferenceSystem) int {
+	if c, ok := crs.(interface{ EPSGCode() int }); ok {
+		return c.EPSGCode()
+	}
+
+	return 0
+}
@@ -0,0 +1,39 @@
+package wgs84
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var wktAuthority = regexp.MustCompile(`(?i)(?:AUTHORITY|ID)\[\s*"EPSG"\s*,\s*"?(\d+)"?\s*\]\s*\]\s*$`)
+
+// ParseGeoPackageSRS returns the CoordinateReferenceSystem of an entry of the
+// gpkg_spatial_ref_sys table of a GeoPackage.
+//
+// The definition can be a PROJ string or WKT. Since this package doesn't
+// parse WKT, the EPSG authority code of the WKT or the srsID is looked up
+// with FromEPSG. The undefined systems -1 and 0 are not supported.
+func ParseGeoPackageSRS(srsID int, def string) (CoordinateReferenceSystem, error) {
+	def = strings.TrimSpace(def)
+
+	if strings.HasPrefix(def, "+") {
+		return ParsePROJ(def)
+	}
+
+	if m := wktAuthority.FindStringSubmatch(def); m != nil {
+		if code, err := strconv.Atoi(m[1]); err == nil {
+			if crs, err := FromEPSG(code); err == nil {
+				return crs, nil
+			}
+		}
+	}
+
+	if srsID > 0 {
+		if crs, err := FromEPSG(srsID); err == nil {
+			return crs, nil
+		}
+	}
+
+	return nil, ErrUnsupportedDefinition
+}
@@ -0,0 +1,173 @@
+package wgs84
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// gr3df97a is a grid of geocentric translations from NTF to RGF93 in meters,
+// indexed by NTF geographic coordinates.
+type gr3df97a struct {
+	west, east, south, north, lonInc, latInc float64
+	cols, rows                               int
+	tx, ty, tz                               []float64
+}
+
+// LoadGR3DF97A provides a Datum similar to NTF with the geocentric
+// translations of the grid GR3DF97A of the IGN.
+//
+// It reads the ASCII file gr3df97a.txt. The translations are interpolated
+// bilinearly between NTF and RGF93, which is taken as WGS84. Outside the grid
+// the 3-parameter-Helmert-Transformation of NTF is used.
+func LoadGR3DF97A(r io.Reader) (Datum, error) {
+	grid, err := readGR3DF97A(r)
+	if err != nil {
+		return Datum{}, err
+	}
+
+	d := NTF()
+	d.Transformation = gr3df97aTransformation{grid: grid, fallback: d.Transformation}
+
+	return d, nil
+}
+
+// readGR3DF97A reads the header GR3D1 with the bounds and increments in
+// degrees and the nodes with longitude, latitude, tx, ty and tz.
+func readGR3DF97A(r io.Reader) (*gr3df97a, error) {
+	var g *gr3df97a
+
+	scanner := bufio.NewScanner(r)
+
+	for n := 1; scanner.Scan(); n++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] != "GR3D1" && strings.HasPrefix(fields[0], "GR3D") {
+			continue
+		}
+
+		if fields[0] == "GR3D1" {
+			fields = fields[1:]
+		}
+
+		if len(fields) < 5 {
+			return nil, fmt.Errorf("%w: line %d", ErrInvalidGrid, n)
+		}
+
+		var v [6]float64
+
+		for i := range v[:5] {
+			f, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidGrid, n, err)
+			}
+
+			v[i] = f
+		}
+
+		if g == nil {
+			if len(fields) < 6 {
+				return nil, fmt.Errorf("%w: line %d: no GR3D1 header", ErrInvalidGrid, n)
+			}
+
+			if v[5], _ = strconv.ParseFloat(fields[5], 64); !(v[4] > 0 && v[5] > 0 && v[1] > v[0] && v[3] > v[2]) {
+				return nil, fmt.Errorf("%w: line %d: GR3D1 header", ErrInvalidGrid, n)
+			}
+
+			g = &gr3df97a{west: v[0], east: v[1], south: v[2], north: v[3], lonInc: v[4], latInc: v[5]}
+			g.cols = int(math.Round((g.east-g.west)/g.lonInc)) + 1
+			g.rows = int(math.Round((g.north-g.south)/g.latInc)) + 1
+			g.tx, g.ty, g.tz = g.nodes(), g.nodes(), g.nodes()
+
+			continue
+		}
+
+		x, y := (v[0]-g.west)/g.lonInc, (v[1]-g.south)/g.latInc
+		col, row := int(math.Round(x)), int(math.Round(y))
+
+		if math.Abs(x-float64(col)) > 1e-6 || math.Abs(y-float64(row)) > 1e-6 ||
+			col < 0 || col >= g.cols || row < 0 || row >= g.rows {
+			return nil, fmt.Errorf("%w: line %d: node %v,%v", ErrInvalidGrid, n, v[0], v[1])
+		}
+
+		i := row*g.cols + col
+		g.tx[i], g.ty[i], g.tz[i] = v[2], v[3], v[4]
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if g == nil {
+		return nil, fmt.Errorf("%w: no GR3D1 header", ErrInvalidGrid)
+	}
+
+	return g, nil
+}
+
+func (g *gr3df97a) nodes() []float64 {
+	v := make([]float64, g.cols*g.rows)
+	for i := range v {
+		v[i] = math.NaN()
+	}
+
+	return v
+}
+
+// translation returns the interpolated translations at NTF coordinates.
+func (g *gr3df97a) translation(lon, lat float64) (tx, ty, tz float64, ok bool) {
+	x, y := (lon-g.west)/g.lonInc, (lat-g.south)/g.latInc
+	col, row := int(math.Floor(x)), int(math.Floor(y))
+
+	if col < 0 || col >= g.cols-1 || row < 0 || row >= g.rows-1 {
+		return 0, 0, 0, false
+	}
+
+	fx, fy := x-float64(col), y-float64(row)
+	i := row*g.cols + col
+
+	bilinear := func(v []float64) float64 {
+		return (1-fy)*((1-fx)*v[i]+fx*v[i+1]) + fy*((1-fx)*v[i+g.cols]+fx*v[i+g.cols+1])
+	}
+
+	tx, ty, tz = bilinear(g.tx), bilinear(g.ty), bilinear(g.tz)
+
+	return tx, ty, tz, !math.IsNaN(tx + ty + tz)
+}
+
+// gr3df97aTransformation transforms NTF to RGF93 with GR3DF97A and falls
+// back to a Transformation outside the grid.
+type gr3df97aTransformation struct {
+	grid     *gr3df97a
+	fallback Transformation
+}
+
+// Forward transforms geocentric coordinates to WGS84.
+func (t gr3df97aTransformation) Forward(x, y, z float64) (x0, y0, z0 float64) {
+	lon, lat, _ := xyzToLonLat(x, y, z, Clarke1880IGN{}.A(), Clarke1880IGN{}.Fi())
+
+	tx, ty, tz, ok := t.grid.translation(lon, lat)
+	if !ok {
+		return t.fallback.Forward(x, y, z)
+	}
+
+	return x + tx, y + ty, z + tz
+}
+
+// Inverse transforms geocentric coordinates from WGS84.
+//
+// The NTF coordinates of the grid are approximated by the Transformation of
+// the fallback, like the algorithm of the IGN.
+func (t gr3df97aTransformation) Inverse(x0, y0, z0 float64) (x, y, z float64) {
+	x, y, z = t.fallback.Inverse(x0, y0, z0)
+	lon, lat, _ := xyzToLonLat(x, y, z, Clarke1880IGN{}.A(), Clarke1880IGN{}.Fi())
+
+	tx, ty, tz, ok := t.grid.translation(lon, lat)
+	if !ok {
+		return t.fallback.Inverse(x0, y0, z0)
+	}
+
+	return x0 - tx, y0 - ty, z0 - tz
+}
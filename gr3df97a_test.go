@@ -0,0 +1,55 @@
+package wgs84_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestLoadGR3DF97A(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+
+	b.WriteString("GR3D  002024 024 20370201\n")
+	b.WriteString("GR3D1   2.0000   3.0000   48.0000   49.0000    .5000    .5000\n")
+	b.WriteString("GR3D2 INTERPOLATION BILINEAIRE\n")
+
+	for lon := 2.0; lon <= 3; lon += 0.5 {
+		for lat := 48.0; lat <= 49; lat += 0.5 {
+			fmt.Fprintf(&b, "%16.9f %14.9f %9.3f %9.3f %9.3f  01  -0158\n", lon, lat, -168+lon, -60-lat/10, 320+lon)
+		}
+	}
+
+	ntf, err := wgs84.LoadGR3DF97A(strings.NewReader(b.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x, y, z := wgs84.Transform(ntf.LonLat(), ntf.XYZ())(2.35, 48.85, 0)
+	x0, y0, z0 := wgs84.Transform(ntf.LonLat(), wgs84.RGF93().XYZ())(2.35, 48.85, 0)
+
+	if math.Abs(x0-x+168-2.35) > 1e-3 || math.Abs(y0-y+60+4.885) > 1e-3 || math.Abs(z0-z-320-2.35) > 1e-3 {
+		t.Fatal(x0-x, y0-y, z0-z)
+	}
+
+	lon, lat, _ := wgs84.Transform(wgs84.RGF93().LonLat(), ntf.LonLat())(wgs84.Transform(ntf.LonLat(), wgs84.RGF93().LonLat())(2.35, 48.85, 0))
+	if math.Abs(lon-2.35) > 1e-8 || math.Abs(lat-48.85) > 1e-8 {
+		t.Fatal(lon, lat)
+	}
+
+	x0, y0, z0 = wgs84.Transform(ntf.LonLat(), wgs84.RGF93().XYZ())(5, 45, 0)
+	hx, hy, hz := wgs84.Transform(wgs84.NTF().LonLat(), wgs84.RGF93().XYZ())(5, 45, 0)
+
+	if x0 != hx || y0 != hy || z0 != hz {
+		t.Fatal(x0, y0, z0)
+	}
+
+	if _, err := wgs84.LoadGR3DF97A(strings.NewReader("GR3D2 INTERPOLATION BILINEAIRE\n")); !errors.Is(err, wgs84.ErrInvalidGrid) {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,249 @@
+package wgs84
+
+import (
+	"fmt"
+	"math"
+)
+
+// graticuleSegment is the maximum length in meters of the segments of the
+// meridians of a Graticule.
+const graticuleSegment = 10000
+
+// Graticule returns the meridians and parallels of a projected Coordinate
+// Reference System as projected polylines, first the meridians from west to
+// east, then the parallels from south to north.
+//
+// The lines are placed at multiples of lonSpacing and latSpacing degrees of
+// the Datum. Meridians are densified with Densify into segments of at most
+// 10 km. Parallels aren't geodesics, so they are sampled every 0.1° of
+// longitude instead. Lines are split where they leave the Area of the
+// Coordinate Reference System or can't be projected.
+//
+// Returns nil if the Projection is nil or a spacing isn't positive.
+func Graticule(crs ProjectedReferenceSystem, lonSpacing, latSpacing float64) [][][2]float64 {
+	if crs.Projection == nil || !(lonSpacing > 0) || !(latSpacing > 0) {
+		return nil
+	}
+
+	var lines [][][2]float64
+
+	for k := math.Ceil(-180 / lonSpacing); k*lonSpacing <= 180; k++ {
+		lon := k * lonSpacing
+		south := Densify(lon, 0, lon, -90, graticuleSegment, crs.Datum)
+		north := Densify(lon, 0, lon, 90, graticuleSegment, crs.Datum)
+
+		meridian := make([][2]float64, 0, len(south)+len(north)-1)
+		for i := len(south) - 1; i >= 0; i-- {
+			meridian = append(meridian, [2]float64{lon, south[i][1]})
+		}
+
+		for _, c := range north[1:] {
+			meridian = append(meridian, [2]float64{lon, c[1]})
+		}
+
+		lines = append(lines, projectLine(crs, meridian)...)
+	}
+
+	const steps = 3600
+
+	for k := math.Ceil(-90 / latSpacing); k*latSpacing <= 90; k++ {
+		lat := k * latSpacing
+		if math.Abs(lat) == 90 {
+			continue
+		}
+
+		parallel := make([][2]float64, steps+1)
+		for i := range parallel {
+			parallel[i] = [2]float64{-180 + 360*float64(i)/steps, lat}
+		}
+
+		lines = append(lines, projectLine(crs, parallel)...)
+	}
+
+	return lines
+}
+
+// projectLine projects a geographic polyline and splits it at coordinates
+// outside of the Area or without projection.
+func projectLine(crs ProjectedReferenceSystem, line [][2]float64) [][][2]float64 {
+	var (
+		parts [][][2]float64
+		part  [][2]float64
+	)
+
+	flush := func() {
+		if len(part) > 1 {
+			parts = append(parts, part)
+		}
+
+		part = nil
+	}
+
+	for _, c := range line {
+		if !crs.Contains(c[0], c[1]) {
+			flush()
+
+			continue
+		}
+
+		east, north := crs.Projection.FromLonLat(c[0], c[1], crs.Datum)
+		if math.IsNaN(east) || math.IsNaN(north) || math.IsInf(east, 0) || math.IsInf(north, 0) {
+			flush()
+
+			continue
+		}
+
+		part = append(part, [2]float64{east, north})
+	}
+
+	flush()
+
+	return parts
+}
+
+// FrameTick is the intersection of a meridian or a parallel with the frame of
+// a map.
+type FrameTick struct {
+	// Easting and Northing are the position of the tick on the frame.
+	Easting, Northing float64
+	// Meridian is true for meridians on the bottom and top edge and false for
+	// parallels on the left and right edge.
+	Meridian bool
+	// Degrees is the longitude of the meridian or the latitude of the parallel.
+	Degrees float64
+	// Label is Degrees in degrees and minutes, like 12°30'E or 45°N.
+	Label string
+}
+
+// FrameTicks returns the ticks of the meridians and parallels at multiples of
+// tickSpacingDeg degrees on the frame of a map in a projected Coordinate
+// Reference System.
+//
+// The ticks of the bottom and top edge are sorted from west to east, those
+// of the left and right edge from south to north. The edges are sampled in
+// 256 steps and the ticks refined by bisection, so lines crossing an edge
+// twice within a step are missed. Parts of the frame that can't be
+// transformed are skipped.
+func FrameTicks(crs ProjectedReferenceSystem, minE, minN, maxE, maxN, tickSpacingDeg float64) []FrameTick {
+	if crs.Projection == nil || !(tickSpacingDeg > 0) {
+		return nil
+	}
+
+	var ticks []FrameTick
+
+	for _, edge := range [...]struct {
+		e0, n0, e1, n1 float64
+		meridian       bool
+	}{
+		{minE, minN, maxE, minN, true},
+		{minE, maxN, maxE, maxN, true},
+		{minE, minN, minE, maxN, false},
+		{maxE, minN, maxE, maxN, false},
+	} {
+		at := func(t float64) (east, north, degrees float64) {
+			east, north = edge.e0+t*(edge.e1-edge.e0), edge.n0+t*(edge.n1-edge.n0)
+			lon, lat := crs.Projection.ToLonLat(east, north, crs.Datum)
+
+			if edge.meridian {
+				return east, north, lon
+			}
+
+			return east, north, lat
+		}
+
+		ticks = append(ticks, edgeTicks(at, edge.meridian, tickSpacingDeg)...)
+	}
+
+	return ticks
+}
+
+func edgeTicks(at func(t float64) (east, north, degrees float64), meridian bool, spacing float64) []FrameTick {
+	const steps = 256
+
+	var ticks []FrameTick
+
+	_, _, v0 := at(0)
+
+	for i := 1; i <= steps; i++ {
+		t0, t1 := float64(i-1)/steps, float64(i)/steps
+		_, _, v1 := at(t1)
+
+		// A jump of the longitude is the antimeridian.
+		if math.IsNaN(v0) || math.IsNaN(v1) || math.Abs(v1-v0) > 180 {
+			v0 = v1
+
+			continue
+		}
+
+		lo, hi := math.Min(v0, v1), math.Max(v0, v1)
+
+		for k := math.Ceil(lo / spacing); k*spacing <= hi; k++ {
+			c := k * spacing
+			if c == 0 {
+				// no negative zero.
+				c = 0
+			}
+
+			// The start of a step belongs to the step before.
+			if c == v0 && i > 1 {
+				continue
+			}
+
+			a, b := t0, t1
+
+			switch c {
+			case v0:
+				b = t0
+			case v1:
+				a = t1
+			}
+
+			for j := 0; j < 60 && b-a > 1e-15; j++ {
+				m := (a + b) / 2
+				if _, _, v := at(m); (v < c) == (v0 < c) && v != c {
+					a = m
+				} else {
+					b = m
+				}
+			}
+
+			east, north, _ := at((a + b) / 2)
+
+			ticks = append(ticks, FrameTick{
+				Easting:  east,
+				Northing: north,
+				Meridian: meridian,
+				Degrees:  c,
+				Label:    degreeLabel(c, meridian),
+			})
+		}
+
+		v0 = v1
+	}
+
+	return ticks
+}
+
+// degreeLabel formats degrees like 12°30'E, rounded to minutes.
+func degreeLabel(degrees float64, meridian bool) string {
+	hemisphere := ""
+
+	switch {
+	case meridian && degrees > 0 && degrees < 180:
+		hemisphere = "E"
+	case meridian && degrees < 0 && degrees > -180:
+		hemisphere = "W"
+	case !meridian && degrees > 0:
+		hemisphere = "N"
+	case !meridian && degrees < 0:
+		hemisphere = "S"
+	}
+
+	minutes := int(math.Round(math.Abs(degrees) * 60))
+
+	if minutes%60 == 0 {
+		return fmt.Sprintf("%d°%s", minutes/60, hemisphere)
+	}
+
+	return fmt.Sprintf("%d°%02d'%s", minutes/60, minutes%60, hemisphere)
+}
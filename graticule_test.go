@@ -0,0 +1,97 @@
+package wgs84_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestGraticule(t *testing.T) {
+	t.Parallel()
+
+	// Web Mercator ends at 85.06°, so the meridians are cut there and the
+	// parallels at ±90° are left out.
+	lines := wgs84.Graticule(wgs84.WebMercator(), 30, 30)
+	if len(lines) != 13+5 {
+		t.Fatal(len(lines))
+	}
+
+	for i, line := range lines[:13] {
+		east := float64(i*30-180) * 20037508.342789244 / 180
+
+		for _, c := range line {
+			if math.Abs(c[0]-east) > 1e-6 {
+				t.Fatal(i, c)
+			}
+		}
+
+		if first, last := line[0], line[len(line)-1]; first[1] > -19e6 || last[1] < 19e6 {
+			t.Fatal(i, first, last)
+		}
+	}
+
+	for _, line := range lines[13:] {
+		for _, c := range line[1:] {
+			if c[1] != line[0][1] {
+				t.Fatal(c, line[0])
+			}
+		}
+	}
+
+	// The Area of a UTM zone splits the parallels at its edges.
+	utm := wgs84.UTM(32, true)
+	for _, line := range wgs84.Graticule(utm, 1, 10) {
+		for _, c := range line {
+			lon, lat := utm.Projection.ToLonLat(c[0], c[1], utm.Datum)
+			if !utm.Contains(lon, lat) && math.Abs(lon-6) > 1e-9 && math.Abs(lon-12) > 1e-9 {
+				t.Fatal(lon, lat)
+			}
+		}
+	}
+
+	if lines := wgs84.Graticule(utm, 0, 10); lines != nil {
+		t.Fatal(lines)
+	}
+}
+
+func TestFrameTicks(t *testing.T) {
+	t.Parallel()
+
+	utm := wgs84.UTM(32, true)
+	ticks := wgs84.FrameTicks(utm, 300000, 5000000, 700000, 6000000, 0.5)
+
+	var labels []string
+
+	for _, tick := range ticks {
+		lon, lat := utm.Projection.ToLonLat(tick.Easting, tick.Northing, utm.Datum)
+
+		if tick.Meridian && (math.Abs(lon-tick.Degrees) > 1e-9 || tick.Northing != 5000000 && tick.Northing != 6000000) ||
+			!tick.Meridian && (math.Abs(lat-tick.Degrees) > 1e-9 || tick.Easting != 300000 && tick.Easting != 700000) {
+			t.Fatal(tick, lon, lat)
+		}
+
+		if tick.Meridian && tick.Northing == 5000000 {
+			labels = append(labels, tick.Label)
+		}
+	}
+
+	if strings.Join(labels, " ") != "6°30'E 7°E 7°30'E 8°E 8°30'E 9°E 9°30'E 10°E 10°30'E 11°E 11°30'E" {
+		t.Fatal(labels)
+	}
+
+	// The frame of Web Mercator ends at the equator and the prime meridian.
+	ticks = wgs84.FrameTicks(wgs84.WebMercator(), -1000000, -1000000, 0, 0, 5)
+	if len(ticks) != 8 {
+		t.Fatal(ticks)
+	}
+
+	for i, label := range []string{"5°W", "0°", "5°W", "0°", "5°S", "0°", "5°S", "0°"} {
+		// Ticks at the corners are exact.
+		if ticks[i].Label != label || ticks[i].Degrees == 0 && ticks[i].Meridian && ticks[i].Easting != 0 ||
+			ticks[i].Degrees == 0 && !ticks[i].Meridian && ticks[i].Northing != 0 {
+			t.Fatal(ticks[i])
+		}
+	}
+}
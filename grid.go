@@ -0,0 +1,82 @@
+package wgs84
+
+import (
+	"io"
+
+	"github.com/wroge/wgs84/datum"
+)
+
+// ErrInvalidGrid is returned by the grid loaders for malformed grid files.
+var ErrInvalidGrid = datum.ErrInvalidGrid
+
+// LoadMGIToETRS89Grid provides a Datum similar to MGI with the datum shifts
+// of the Austrian GIS-Grid in the NTv2 format, like AT_GIS_GRID.gsb of the
+// BEV.
+//
+// The shifts are interpolated bilinearly from MGI to ETRS89. Outside the grid
+// the 7-parameter-Helmert-Transformation of MGI is used.
+func LoadMGIToETRS89Grid(r io.Reader) (Datum, error) {
+	grid, err := datum.ReadNTv2(r)
+	if err != nil {
+		return Datum{}, err
+	}
+
+	return gridDatum(MGI(), grid), nil
+}
+
+// LoadIrishCorrectionGrid provides a Datum similar to Ireland1965 with the
+// datum shifts of the OSi/OSNI grid in the NTv2 format, like
+// TM75_ETRS89.gsb.
+//
+// The shifts are interpolated bilinearly from Ireland1965 (TM75) to ETRS89,
+// which is realized as IRENET95 in Ireland. Outside the grid the
+// 7-parameter-Helmert-Transformation of Ireland1965 is used.
+func LoadIrishCorrectionGrid(r io.Reader) (Datum, error) {
+	grid, err := datum.ReadNTv2(r)
+	if err != nil {
+		return Datum{}, err
+	}
+
+	return gridDatum(Ireland1965(), grid), nil
+}
+
+// LoadPENR2009 provides a Datum similar to ED50 with the datum shifts of the
+// PENR2009 grid of the IGN Spain in the NTv2 format, like PENR2009.gsb.
+//
+// The shifts are interpolated bilinearly from ED50 to ETRS89. Outside the
+// grid the 7-parameter-Helmert-Transformation of ED50 is used.
+func LoadPENR2009(r io.Reader) (Datum, error) {
+	grid, err := datum.ReadNTv2(r)
+	if err != nil {
+		return Datum{}, err
+	}
+
+	return gridDatum(ED50(), grid), nil
+}
+
+// LoadROM40ToETRS89Grid provides a Datum similar to MonteMario (Roma40) with
+// the datum shifts of a grid in the NTv2 format.
+//
+// The shifts are interpolated bilinearly from Roma40 to ETRS89. Outside the
+// grid the 7-parameter-Helmert-Transformation of MonteMario is used.
+func LoadROM40ToETRS89Grid(r io.Reader) (Datum, error) {
+	grid, err := datum.ReadNTv2(r)
+	if err != nil {
+		return Datum{}, err
+	}
+
+	return gridDatum(MonteMario(), grid), nil
+}
+
+// gridDatum returns the Datum d with a GridShift to ETRS89 that falls back to
+// the Transformation of d.
+func gridDatum(d Datum, grid datum.Grid) Datum {
+	d.Transformation = datum.GridShift{
+		Grid:     grid,
+		From:     d.Spheroid,
+		To:       GRS80{},
+		Fallback: d.Transformation,
+	}
+
+	return d
+}
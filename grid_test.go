@@ -0,0 +1,234 @@
+package wgs84_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+// ntv2Grid returns a single-subgrid NTv2 file in seconds with shifts in
+// seconds, longitudes positive east.
+func ntv2Grid(order binary.ByteOrder, west, east, south, north, inc float64,
+	shift func(lon, lat float64) (dlon, dlat float64),
+) []byte {
+	var buf bytes.Buffer
+
+	record := func(name string, value interface{}) {
+		key := make([]byte, 8)
+		copy(key, name)
+		buf.Write(key)
+
+		switch v := value.(type) {
+		case int:
+			_ = binary.Write(&buf, order, int32(v))
+			buf.Write(make([]byte, 4))
+		case float64:
+			_ = binary.Write(&buf, order, v)
+		case string:
+			text := []byte("        ")
+			copy(text, v)
+			buf.Write(text)
+		}
+	}
+
+	rows := int(math.Round((north-south)/inc)) + 1
+	cols := int(math.Round((east-west)/inc)) + 1
+
+	record("NUM_OREC", 11)
+	record("NUM_SREC", 11)
+	record("NUM_FILE", 1)
+	record("GS_TYPE", "SECONDS")
+	record("VERSION", "NTv2.0")
+	record("SYSTEM_F", "MGI")
+	record("SYSTEM_T", "ETRS89")
+	record("MAJOR_F", 6377397.155)
+	record("MINOR_F", 6356078.963)
+	record("MAJOR_T", 6378137.0)
+	record("MINOR_T", 6356752.314)
+	record("SUB_NAME", "TEST")
+	record("PARENT", "NONE")
+	record("CREATED", "")
+	record("UPDATED", "")
+	record("S_LAT", south*3600)
+	record("N_LAT", north*3600)
+	record("E_LONG", -east*3600)
+	record("W_LONG", -west*3600)
+	record("LAT_INC", inc*3600)
+	record("LONG_INC", inc*3600)
+	record("GS_COUNT", rows*cols)
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			dlon, dlat := shift(east-float64(c)*inc, south+float64(r)*inc)
+			_ = binary.Write(&buf, order, []float32{float32(dlat), float32(-dlon), 0, 0})
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func TestLoadMGIToETRS89Grid(t *testing.T) {
+	t.Parallel()
+
+	shift := func(lon, lat float64) (float64, float64) {
+		return 1 + 0.5*(lon-9), -2 + 0.25*(lat-46)
+	}
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		mgi, err := wgs84.LoadMGIToETRS89Grid(bytes.NewReader(ntv2Grid(order, 9, 18, 46, 49.5, 0.5, shift)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, p := range [][2]float64{{13, 47}, {15.3, 48.1}, {9.9, 46.75}} {
+			lon, lat, _ := wgs84.Transform(mgi.LonLat(), wgs84.ETRS89().LonLat())(p[0], p[1], 0)
+			dlon, dlat := shift(p[0], p[1])
+
+			if math.Abs(lon-p[0]-dlon/3600) > 1e-9 || math.Abs(lat-p[1]-dlat/3600) > 1e-9 {
+				t.Fatal(p, (lon-p[0])*3600, (lat-p[1])*3600, dlon, dlat)
+			}
+
+			lon, lat, _ = wgs84.Transform(wgs84.ETRS89().LonLat(), mgi.LonLat())(lon, lat, 0)
+
+			if math.Abs(lon-p[0]) > 1e-10 || math.Abs(lat-p[1]) > 1e-10 {
+				t.Fatal(p, lon, lat)
+			}
+		}
+
+		lon, lat, _ := wgs84.Transform(mgi.LonLat(), wgs84.ETRS89().LonLat())(20, 47, 0)
+		hlon, hlat, _ := wgs84.Transform(wgs84.MGI().LonLat(), wgs84.ETRS89().LonLat())(20, 47, 0)
+
+		if lon != hlon || lat != hlat {
+			t.Fatal(lon, lat, hlon, hlat)
+		}
+	}
+
+	if _, err := wgs84.LoadMGIToETRS89Grid(bytes.NewReader([]byte("NUM_OREC"))); !errors.Is(err, wgs84.ErrInvalidGrid) {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadIrishCorrectionGrid(t *testing.T) {
+	t.Parallel()
+
+	shift := func(lon, lat float64) (float64, float64) {
+		return -2.5 + 0.1*(lon+11), 1.8 - 0.05*(lat-51)
+	}
+
+	tm75, err := wgs84.LoadIrishCorrectionGrid(bytes.NewReader(ntv2Grid(binary.LittleEndian, -11, -5, 51, 56, 1.0/12, shift)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tm75.A() != wgs84.Ireland1965().A() {
+		t.Fatal(tm75.A())
+	}
+
+	lon, lat, _ := wgs84.Transform(tm75.LonLat(), wgs84.ETRS89().LonLat())(-6.25, 53.35, 0)
+	dlon, dlat := shift(-6.25, 53.35)
+
+	if math.Abs(lon+6.25-dlon/3600) > 1e-9 || math.Abs(lat-53.35-dlat/3600) > 1e-9 {
+		t.Fatal(lon, lat)
+	}
+}
+
+func TestLoadPENR2009(t *testing.T) {
+	t.Parallel()
+
+	shift := func(lon, lat float64) (float64, float64) {
+		return -4.2 + 0.05*(lon+10), -3.1 + 0.02*(lat-35)
+	}
+
+	ed50, err := wgs84.LoadPENR2009(bytes.NewReader(ntv2Grid(binary.BigEndian, -10, 5, 35, 44.5, 1.0/12, shift)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lon, lat, _ := wgs84.Transform(ed50.LonLat(), wgs84.ETRS89().LonLat())(-3.7, 40.4, 0)
+	dlon, dlat := shift(-3.7, 40.4)
+
+	if math.Abs(lon+3.7-dlon/3600) > 1e-9 || math.Abs(lat-40.4-dlat/3600) > 1e-9 {
+		t.Fatal(lon, lat)
+	}
+
+	lon, lat, _ = wgs84.Transform(wgs84.ETRS89().LonLat(), ed50.LonLat())(lon, lat, 0)
+	if math.Abs(lon+3.7) > 1e-10 || math.Abs(lat-40.4) > 1e-10 {
+		t.Fatal(lon, lat)
+	}
+}
+
+func TestLoadROM40ToETRS89Grid(t *testing.T) {
+	t.Parallel()
+
+	shift := func(lon, lat float64) (float64, float64) {
+		return -2.6 + 0.04*(lon-6), 2.3 - 0.03*(lat-36)
+	}
+
+	roma40, err := wgs84.LoadROM40ToETRS89Grid(bytes.NewReader(ntv2Grid(binary.LittleEndian, 6, 19, 36, 47.5, 1.0/12, shift)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lon, lat, _ := wgs84.Transform(roma40.LonLat(), wgs84.ETRS89().LonLat())(12.45, 41.9, 0)
+	dlon, dlat := shift(12.45, 41.9)
+
+	if math.Abs(lon-12.45-dlon/3600) > 1e-9 || math.Abs(lat-41.9-dlat/3600) > 1e-9 {
+		t.Fatal(lon, lat)
+	}
+
+	lon, lat, _ = wgs84.Transform(wgs84.ETRS89().LonLat(), roma40.LonLat())(lon, lat, 0)
+	if math.Abs(lon-12.45) > 1e-10 || math.Abs(lat-41.9) > 1e-10 {
+		t.Fatal(lon, lat)
+	}
+}
+
+func BenchmarkNTv2Transform(b *testing.B) {
+	shift := func(lon, lat float64) (float64, float64) {
+		return 1.5 + 0.1*(lon-9), -0.8 + 0.05*(lat-46)
+	}
+
+	mgi, err := wgs84.LoadMGIToETRS89Grid(bytes.NewReader(ntv2Grid(binary.LittleEndian, 9, 17.5, 46, 49.5, 1.0/60, shift)))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	transform := wgs84.Transform(mgi.LonLat(), wgs84.ETRS89().LonLat())
+
+	b.Run("Point", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			_, _, _ = transform(16.37, 48.21, 0)
+		}
+	})
+
+	// the batch transforms 1000 points per operation.
+	points := make([][3]float64, 1000)
+	for i := range points {
+		points[i] = [3]float64{9.5 + 7.5*float64(i%40)/40, 46.5 + 2.5*float64(i/40)/25, 0}
+	}
+
+	b.Run("Batch", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			for j := range points {
+				_, _, _ = transform(points[j][0], points[j][1], points[j][2])
+			}
+		}
+	})
+
+	b.Run("Inverse", func(b *testing.B) {
+		inverse := wgs84.Transform(wgs84.ETRS89().LonLat(), mgi.LonLat())
+
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			_, _, _ = inverse(16.37, 48.21, 0)
+		}
+	})
+}
@@ -0,0 +1,178 @@
+package wgs84
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Registry interface provides CoordinateReferenceSystem's by EPSG-Code.
+//
+// It is implemented by the Repository.
+type Registry interface {
+	Code(c int) CoordinateReferenceSystem
+}
+
+const (
+	httpRate    = 100
+	httpBurst   = 200
+	httpMaxBody = 1 << 20
+)
+
+// NewHTTPHandler returns a http.Handler exposing the transformation of
+// coordinates between the EPSG-Codes of a Registry.
+//
+// It serves POST /transform with a JSON body like
+//
+//	{"from":"EPSG:4326","to":"EPSG:32632","coordinates":[[9.0,48.0,0.0]]}
+//
+// and responds with {"coordinates":[[...]]}. Coordinates that can't be
+// transformed to finite values are rejected with 422 Unprocessable Entity and
+// request bodies are limited to 1 MiB.
+//
+// Requests are rate limited by a single token bucket with 100 requests per
+// second and a burst of 200, which is shared by all clients of the handler.
+// Per-client limits belong to a middleware in front of the handler. CORS
+// headers allow the use from browsers.
+func NewHTTPHandler(registry Registry) http.Handler {
+	bucket := &tokenBucket{
+		rate:   httpRate,
+		burst:  httpBurst,
+		tokens: httpBurst,
+		last:   time.Now(),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/transform", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+
+		switch {
+		case r.Method == http.MethodOptions:
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method != http.MethodPost:
+			httpError(w, http.StatusMethodNotAllowed, "method not allowed")
+		case !bucket.take():
+			httpError(w, http.StatusTooManyRequests, "rate limit exceeded")
+		default:
+			serveTransform(w, r, registry)
+		}
+	})
+
+	return mux
+}
+
+func serveTransform(w http.ResponseWriter, r *http.Request, registry Registry) {
+	var req struct {
+		From        string      `json:"from"`
+		To          string      `json:"to"`
+		Coordinates [][]float64 `json:"coordinates"`
+	}
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, httpMaxBody)).Decode(&req); err != nil {
+		httpError(w, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	from, err := registryCode(registry, req.From)
+	if err != nil {
+		httpError(w, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	to, err := registryCode(registry, req.To)
+	if err != nil {
+		httpError(w, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	transform := Transform(from, to)
+	coordinates := make([][3]float64, len(req.Coordinates))
+
+	for i, c := range req.Coordinates {
+		if len(c) < 2 || len(c) > 3 {
+			httpError(w, http.StatusBadRequest, fmt.Sprintf("invalid coordinate at index %d", i))
+
+			return
+		}
+
+		c = append(c, 0)
+		coordinates[i][0], coordinates[i][1], coordinates[i][2] = transform(c[0], c[1], c[2])
+
+		if !finite(coordinates[i][0]) || !finite(coordinates[i][1]) || !finite(coordinates[i][2]) {
+			httpError(w, http.StatusUnprocessableEntity, fmt.Sprintf("coordinate at index %d can't be transformed", i))
+
+			return
+		}
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"coordinates": coordinates})
+	if err != nil {
+		httpError(w, http.StatusInternalServerError, err.Error())
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(append(body, '\n'))
+}
+
+func finite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+func registryCode(registry Registry, s string) (CoordinateReferenceSystem, error) {
+	code, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(s)), "EPSG:"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid EPSG-Code %q", s)
+	}
+
+	crs := registry.Code(code)
+	if crs == nil {
+		return nil, fmt.Errorf("unknown EPSG-Code %d", code)
+	}
+
+	return crs, nil
+}
+
+func httpError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": msg})
+}
+
+type tokenBucket struct {
+	rate, burst, tokens float64
+	last                time.Time
+	mutex               sync.Mutex
+}
+
+func (b *tokenBucket) take() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	b.last = now
+
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+
+	return true
+}
@@ -0,0 +1,30 @@
+package wgs84_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestHTTPHandler(t *testing.T) {
+	t.Parallel()
+
+	handler := wgs84.NewHTTPHandler(wgs84.EPSG())
+
+	for body, status := range map[string]int{
+		`{"from":"EPSG:4326","to":"EPSG:32632","coordinates":[[9.0,48.0,0.0]]}`:               http.StatusOK,
+		`{"from":"EPSG:6414","to":"EPSG:4326","coordinates":[[1e8,1e8,0]]}`:                   http.StatusUnprocessableEntity,
+		`{"from":"EPSG:4326","to":"EPSG:1","coordinates":[[9.0,48.0,0.0]]}`:                   http.StatusBadRequest,
+		`{"from":"EPSG:4326","coordinates":[` + strings.Repeat("[9,48],", 1<<17) + `[9,48]]}`: http.StatusBadRequest,
+	} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/transform", strings.NewReader(body)))
+
+		if rec.Code != status || rec.Body.Len() == 0 {
+			t.Fatal(body[:40], rec.Code, rec.Body.String())
+		}
+	}
+}
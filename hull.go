@@ -0,0 +1,91 @@
+package wgs84
+
+import (
+	"math"
+	"sort"
+)
+
+// ConvexHullGeo computes the convex hull of geographic coordinates of a Datum.
+//
+// The coordinates are transformed to geocentric coordinates and projected
+// gnomonically around their mean direction, so the edges of the hull follow
+// great circles instead of straight lines in lon/lat. All coordinates have
+// to lie within one hemisphere.
+//
+// The hull is returned clockwise and is not closed.
+func ConvexHullGeo(coords [][2]float64, d Datum) [][2]float64 {
+	if len(coords) < 3 {
+		return append([][2]float64(nil), coords...)
+	}
+
+	xyz := make([][3]float64, len(coords))
+
+	var cx, cy, cz float64
+
+	for i, c := range coords {
+		x, y, z := lonLatToXYZ(c[0], c[1], 0, d.A(), d.Fi())
+		r := math.Sqrt(x*x + y*y + z*z)
+		xyz[i] = [3]float64{x / r, y / r, z / r}
+		cx += x / r
+		cy += y / r
+		cz += z / r
+	}
+
+	λ := math.Atan2(cy, cx)
+	φ := math.Atan2(cz, math.Hypot(cx, cy))
+
+	east := [3]float64{-math.Sin(λ), math.Cos(λ), 0}
+	north := [3]float64{-math.Sin(φ) * math.Cos(λ), -math.Sin(φ) * math.Sin(λ), math.Cos(φ)}
+	up := [3]float64{math.Cos(φ) * math.Cos(λ), math.Cos(φ) * math.Sin(λ), math.Sin(φ)}
+
+	type point struct {
+		x, y float64
+		i    int
+	}
+
+	points := make([]point, len(xyz))
+
+	for i, p := range xyz {
+		u := dot(p, up)
+		points[i] = point{x: dot(p, east) / u, y: dot(p, north) / u, i: i}
+	}
+
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].x == points[j].x {
+			return points[i].y < points[j].y
+		}
+
+		return points[i].x < points[j].x
+	})
+
+	cross := func(o, a, b point) float64 {
+		return (a.x-o.x)*(b.y-o.y) - (a.y-o.y)*(b.x-o.x)
+	}
+
+	hull := make([]point, 0, 2*len(points))
+
+	for _, p := range points {
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+
+		hull = append(hull, p)
+	}
+
+	for i, t := len(points)-2, len(hull)+1; i >= 0; i-- {
+		for len(hull) >= t && cross(hull[len(hull)-2], hull[len(hull)-1], points[i]) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+
+		hull = append(hull, points[i])
+	}
+
+	hull = hull[:len(hull)-1]
+	result := make([][2]float64, len(hull))
+
+	for i, p := range hull {
+		result[len(hull)-1-i] = coords[p.i]
+	}
+
+	return result
+}
@@ -1,28 +1,25 @@
 package wgs84
 
+import "github.com/wroge/wgs84/datum"
+
 // Spheroid interface represents an ellipsoid of revolution used by
 // geodetic datums.
 //
 // It is specified by the major axis and the inverse flattening.
-type Spheroid interface {
-	A() float64
-	Fi() float64
-}
+type Spheroid = datum.Spheroid
 
 // Transformation interface represents the transformation of geocentric
 // coordinates To and From WGS84.
 //
 // The Forward method is used to transform coordinates to the WGS84 System..
-type Transformation interface {
-	Forward(x, y, z float64) (x0, y0, z0 float64)
-	Inverse(x0, y0, z0 float64) (x, y, z float64)
-}
+type Transformation = datum.Transformation
 
 // Projection interface is used by the several Projected Coordinate
 // Reference System's in this package.
 //
 // It is easy to expand the package with additional Projections
-// through this interface.
+// through this interface. The Projections of this package are implemented
+// in the proj package.
 type Projection interface {
 	ToLonLat(east, north float64, s Spheroid) (lon, lat float64)
 	FromLonLat(lon, lat float64, s Spheroid) (east, north float64)
@@ -42,6 +39,21 @@ type CoordinateReferenceSystem interface {
 // Reference System.
 //
 // It is implemented by the AreaFunc.
-type Area interface {
-	Contains(lon, lat float64) bool
+type Area = datum.Area
+
+// CRSMetadata interface provides human-readable information about a
+// Coordinate Reference System.
+//
+// It is implemented by the CoordinateReferenceSystem's in this package.
+//
+// This is a breaking change: the metadata is stored in unexported fields of
+// the GeocentricReferenceSystem, GeographicReferenceSystem and
+// ProjectedReferenceSystem. Composite literals of these types must use keyed
+// fields, and values with different metadata don't compare equal, for
+// example WGS84().LonLat() != WGS84LonLat(). Fingerprint compares the
+// definitions without metadata.
+type CRSMetadata interface {
+	EPSGCode() int
+	Name() string
+	AreaOfUse() Area
 }
@@ -0,0 +1,75 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestIrishGrids(t *testing.T) {
+	t.Parallel()
+
+	ing, itm := wgs84.IrishNationalGrid(), wgs84.IrishTransverseMercator()
+
+	for _, c := range []struct {
+		crs         wgs84.ProjectedReferenceSystem
+		east, north float64
+	}{
+		{ing, 200000, 250000},
+		{wgs84.NorthernIrelandING(), 200000, 250000},
+		{itm, 600000, 750000},
+	} {
+		if east, north := c.crs.Projection.FromLonLat(-8, 53.5, c.crs.Datum); east != c.east || math.Abs(north-c.north) > 1e-9 {
+			t.Fatal(c.crs.Name(), east, north)
+		}
+	}
+
+	// Belfast, Dublin and Cork.
+	for _, c := range []struct {
+		lon, lat float64
+		ni       bool
+	}{
+		{-5.93, 54.6, true},
+		{-6.26, 53.35, false},
+		{-8.47, 51.9, false},
+	} {
+		if !ing.Contains(c.lon, c.lat) || !itm.Contains(c.lon, c.lat) || wgs84.NorthernIrelandING().Contains(c.lon, c.lat) != c.ni {
+			t.Fatal(c)
+		}
+	}
+
+	if ing.Contains(-3, 54) || itm.Contains(-3, 54) {
+		t.Fatal("Great Britain")
+	}
+
+	toITM, toING := wgs84.Transform(ing, itm), wgs84.Transform(itm, ing)
+
+	// The Spire of Dublin is published as O 15904 34671 in the Irish Grid and
+	// as 715830, 734697 in ITM, both to a meter.
+	if e, n, _ := toITM(315904, 234671, 0); math.Abs(e-715830) > 1 || math.Abs(n-734697) > 1 {
+		t.Fatal(e, n)
+	}
+
+	if e, n, _ := toING(715830, 734697, 0); math.Abs(e-315904) > 1 || math.Abs(n-234671) > 1 {
+		t.Fatal(e, n)
+	}
+
+	for east := 20000.0; east <= 360000; east += 20000 {
+		for north := 20000.0; north <= 460000; north += 20000 {
+			e, n, _ := toITM(east, north, 0)
+
+			// the grids are 400 km and 500 km apart, the difference of the
+			// scale and the datums is less than 150 m.
+			if math.Hypot(e-east-400000, n-north-500000) > 150 {
+				t.Fatal(east, north, e, n)
+			}
+
+			// the inverse of the Helmert-Transformation is approximated.
+			e, n, _ = toING(e, n, 0)
+			if math.Abs(e-east) > 0.01 || math.Abs(n-north) > 0.01 {
+				t.Fatal(east, north, e, n)
+			}
+		}
+	}
+}
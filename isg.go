@@ -0,0 +1,167 @@
+package wgs84
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// isg is a geoid grid in the format of the International Service for the
+// Geoid, stored from south to north and west to east.
+type isg struct {
+	south, west, latInc, lonInc float64
+	rows, cols                  int
+	n                           []float64
+}
+
+// LoadITALGEO2005 provides the ITALGEO2005 GeoidModel of the IGM Italy for
+// ETRS89 coordinates.
+//
+// It reads the grid in the ISG format 1.0 or 2.0 of the International Service
+// for the Geoid, with a header between begin_of_head and end_of_head and rows
+// from north to south. The undulations are interpolated bilinearly and are
+// NaN outside the grid and at nodata nodes.
+func LoadITALGEO2005(r io.Reader) (GeoidModel, error) {
+	return readISG(r)
+}
+
+func readISG(r io.Reader) (*isg, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+
+	header := map[string]string{}
+	inHeader := false
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case strings.HasPrefix(line, "begin_of_head"):
+			inHeader = true
+		case strings.HasPrefix(line, "end_of_head"):
+			return readISGData(scanner, header)
+		case inHeader:
+			if key, value, ok := strings.Cut(line, "="); ok {
+				header[strings.TrimSpace(key)] = strings.TrimSpace(value)
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("%w: no ISG header", ErrInvalidGrid)
+}
+
+func readISGData(scanner *bufio.Scanner, header map[string]string) (*isg, error) {
+	var v [6]float64
+
+	for i, key := range []string{"lat min", "lat max", "lon min", "lon max", "delta lat", "delta lon"} {
+		f, err := isgAngle(header[key])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidGrid, key, err)
+		}
+
+		v[i] = f
+	}
+
+	rows, err1 := strconv.Atoi(header["nrows"])
+	cols, err2 := strconv.Atoi(header["ncols"])
+
+	if err1 != nil || err2 != nil || rows < 2 || cols < 2 || !(v[4] > 0 && v[5] > 0) {
+		return nil, fmt.Errorf("%w: ISG grid size", ErrInvalidGrid)
+	}
+
+	g := &isg{south: v[0], west: v[2], latInc: v[4], lonInc: v[5], rows: rows, cols: cols}
+
+	// the bounds are the edges of the cells if they aren't the nodes.
+	if math.Abs((v[1]-v[0])/v[4]-float64(rows)) < 1e-6 {
+		g.south += v[4] / 2
+		g.west += v[5] / 2
+	}
+
+	nodata := math.NaN()
+	if s, ok := header["nodata"]; ok {
+		nodata, _ = strconv.ParseFloat(s, 64)
+	}
+
+	g.n = make([]float64, 0, rows*cols)
+
+	for scanner.Scan() {
+		for _, field := range strings.Fields(scanner.Text()) {
+			f, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidGrid, err)
+			}
+
+			if f == nodata {
+				f = math.NaN()
+			}
+
+			g.n = append(g.n, f)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(g.n) != rows*cols {
+		return nil, fmt.Errorf("%w: ISG grid has %d of %d nodes", ErrInvalidGrid, len(g.n), rows*cols)
+	}
+
+	return g, nil
+}
+
+// isgAngle parses decimal degrees or degrees, minutes and seconds like
+// 36°30'00".
+func isgAngle(s string) (float64, error) {
+	if !strings.ContainsAny(s, "°'\"") {
+		return strconv.ParseFloat(s, 64)
+	}
+
+	var angle float64
+
+	sign := 1.0
+	if strings.HasPrefix(s, "-") {
+		sign, s = -1, s[1:]
+	}
+
+	for i, sep := range []string{"°", "'", "\""} {
+		part, rest, _ := strings.Cut(s, sep)
+
+		f, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return 0, err
+		}
+
+		angle += f / math.Pow(60, float64(i))
+		s = rest
+	}
+
+	return sign * angle, nil
+}
+
+// Undulation method is the implementation of the GeoidModel interface.
+func (g *isg) Undulation(lon, lat float64) float64 {
+	x, y := (lon-g.west)/g.lonInc, (lat-g.south)/g.latInc
+	col, row := int(math.Floor(x)), int(math.Floor(y))
+
+	if col < 0 || col >= g.cols-1 || row < 0 || row >= g.rows-1 {
+		return math.NaN()
+	}
+
+	fx, fy := x-float64(col), y-float64(row)
+
+	// rows are stored from north to south.
+	node := func(r, c int) float64 {
+		return g.n[(g.rows-1-r)*g.cols+c]
+	}
+
+	return (1-fy)*((1-fx)*node(row, col)+fx*node(row, col+1)) +
+		fy*((1-fx)*node(row+1, col)+fx*node(row+1, col+1))
+}
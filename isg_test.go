@@ -0,0 +1,81 @@
+package wgs84_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+// isgFile returns a geoid grid in the ISG format 2.0 from 36°N to 47°N and 6°E
+// to 19°E in 1° steps with the undulation 40 + lon - lat/2.
+func isgFile(dms bool) string {
+	var b strings.Builder
+
+	b.WriteString("comment\nbegin_of_head ================================================\n")
+	b.WriteString("model name     : ITALGEO2005\ndata ordering  : N-to-S, W-to-E\n")
+
+	if dms {
+		b.WriteString("lat min        =   36°00'00\"\nlat max        =   47°00'00\"\n")
+		b.WriteString("lon min        =    6°00'00\"\nlon max        =   19°00'00\"\n")
+		b.WriteString("delta lat      =    1°00'00\"\ndelta lon      =    0°60'00\"\n")
+	} else {
+		b.WriteString("lat min        =   36.000000\nlat max        =   47.000000\n")
+		b.WriteString("lon min        =    6.000000\nlon max        =   19.000000\n")
+		b.WriteString("delta lat      =    1.000000\ndelta lon      =    1.000000\n")
+	}
+
+	b.WriteString("nrows          =         12\nncols          =         14\nnodata         =  -9999.0000\n")
+	b.WriteString("ISG format     =        2.0\nend_of_head ==================================================\n")
+
+	for lat := 47; lat >= 36; lat-- {
+		for lon := 6; lon <= 19; lon++ {
+			n := 40 + float64(lon) - float64(lat)/2
+			if lon == 19 && lat == 36 {
+				n = -9999
+			}
+
+			fmt.Fprintf(&b, " %10.4f", n)
+		}
+
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func TestLoadITALGEO2005(t *testing.T) {
+	t.Parallel()
+
+	for _, dms := range []bool{false, true} {
+		geoid, err := wgs84.LoadITALGEO2005(strings.NewReader(isgFile(dms)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if n := geoid.Undulation(12.45, 41.9); math.Abs(n-(40+12.45-41.9/2)) > 1e-9 {
+			t.Fatal(n)
+		}
+
+		if n := geoid.Undulation(18.5, 36.5); !math.IsNaN(n) {
+			t.Fatal(n)
+		}
+
+		if n := geoid.Undulation(5, 41.9); !math.IsNaN(n) {
+			t.Fatal(n)
+		}
+	}
+
+	_, err := wgs84.LoadITALGEO2005(strings.NewReader(isgFile(false)[:2000]))
+	if !errors.Is(err, wgs84.ErrInvalidGrid) {
+		t.Fatal(err)
+	}
+
+	_, err = wgs84.LoadITALGEO2005(strings.NewReader("36 47 6 19\n"))
+	if !errors.Is(err, wgs84.ErrInvalidGrid) {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,42 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestJapanPlaneRectangular(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		zone             int
+		lonf, latf       float64
+		name             string
+		cityLon, cityLat float64
+	}{
+		{1, 129.5, 33, "JGD2011 / Japan Plane Rectangular CS I", 129.87, 32.75},
+		{9, 139 + 50.0/60, 36, "JGD2011 / Japan Plane Rectangular CS IX", 139.77, 35.68},
+		{13, 144 + 15.0/60, 44, "JGD2011 / Japan Plane Rectangular CS XIII", 144.38, 42.98},
+		{15, 127.5, 26, "JGD2011 / Japan Plane Rectangular CS XV", 127.68, 26.21},
+	} {
+		crs := wgs84.JapanPlaneRectangular(c.zone)
+
+		if crs.EPSGCode() != 6668+c.zone || crs.Name() != c.name {
+			t.Fatal(crs.EPSGCode(), crs.Name())
+		}
+
+		if east, north := crs.Projection.FromLonLat(c.lonf, c.latf, crs.Datum); math.Abs(east) > 1e-6 || math.Abs(north) > 1e-6 {
+			t.Fatal(c.zone, east, north)
+		}
+
+		if !crs.Contains(c.cityLon, c.cityLat) {
+			t.Fatal(c.zone, c.cityLon, c.cityLat)
+		}
+	}
+
+	if crs := wgs84.JapanPlaneRectangular(20); crs.Projection != nil {
+		t.Fatal(crs)
+	}
+}
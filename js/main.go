@@ -0,0 +1,59 @@
+//go:build js && wasm
+
+// Command js exports the coordinate transformation of the wgs84 package to
+// JavaScript.
+//
+//	GOOS=js GOARCH=wasm go build -o wgs84.wasm ./js
+//
+// After the WebAssembly module is started, the global function
+// transform(fromEPSG, toEPSG, lon, lat, alt) returns an array of the three
+// transformed coordinates or an Error. The EPSG Repository is initialized on
+// the first call.
+package main
+
+import (
+	"fmt"
+	"sync"
+	"syscall/js"
+
+	"github.com/wroge/wgs84"
+)
+
+var (
+	registry     *wgs84.Repository
+	registryOnce sync.Once
+)
+
+func main() {
+	js.Global().Set("transform", js.FuncOf(transform))
+
+	select {}
+}
+
+func transform(_ js.Value, args []js.Value) any {
+	if len(args) != 5 {
+		return jsError("transform expects 5 arguments: fromEPSG, toEPSG, lon, lat, alt")
+	}
+
+	registryOnce.Do(func() {
+		registry = wgs84.EPSG()
+	})
+
+	from := registry.Code(args[0].Int())
+	if from == nil {
+		return jsError(fmt.Sprintf("unknown EPSG-Code %d", args[0].Int()))
+	}
+
+	to := registry.Code(args[1].Int())
+	if to == nil {
+		return jsError(fmt.Sprintf("unknown EPSG-Code %d", args[1].Int()))
+	}
+
+	a, b, c := wgs84.Transform(from, to)(args[2].Float(), args[3].Float(), args[4].Float())
+
+	return js.ValueOf([]any{a, b, c})
+}
+
+func jsError(msg string) any {
+	return js.Global().Get("Error").New(msg)
+}
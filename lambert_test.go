@@ -0,0 +1,95 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestLambertConformalConic(t *testing.T) {
+	t.Parallel()
+
+	// EPSG Guidance Note 7-2, Lambert Conic Conformal (1SP) with JAD69 /
+	// Jamaica National Grid and (2SP) with NAD27 / Texas South Central in US
+	// survey feet.
+	const ftUS = 1200.0 / 3937
+
+	clarke1866 := wgs84.Datum{Spheroid: wgs84.Clarke1866{}}
+
+	for _, c := range []struct {
+		crs                   wgs84.ProjectedReferenceSystem
+		lon, lat, east, north float64
+	}{
+		{
+			clarke1866.LambertConformalConic1SP(-77, 18, 1, 250000, 150000),
+			-(76 + 56.0/60 + 37.26/3600), 17 + 55.0/60 + 55.80/3600, 255966.58, 142493.51,
+		},
+		{
+			clarke1866.LambertConformalConic2SP(-99, 27+50.0/60, 28+23.0/60, 30+17.0/60, 2000000*ftUS, 0),
+			-96, 28.5, 2963503.91 * ftUS, 254759.80 * ftUS,
+		},
+	} {
+		east, north := c.crs.Projection.FromLonLat(c.lon, c.lat, c.crs.Datum)
+		if math.Abs(east-c.east) > 0.01 || math.Abs(north-c.north) > 0.01 {
+			t.Fatal(east, north)
+		}
+
+		lon, lat := c.crs.Projection.ToLonLat(c.east, c.north, c.crs.Datum)
+		if math.Abs(lon-c.lon) > 1e-7 || math.Abs(lat-c.lat) > 1e-7 {
+			t.Fatal(lon, lat)
+		}
+	}
+
+	// two standard parallels converging to one are the 1SP variant with a
+	// scale factor of 1.
+	for _, lat0 := range []float64{50.797815, -35} {
+		sp1 := wgs84.WGS84().LambertConformalConic1SP(4.359215833333333, lat0, 1, 150000, 250000)
+
+		for _, δ := range []float64{0, 1e-3, 1e-5} {
+			sp2 := wgs84.WGS84().LambertConformalConic2SP(4.359215833333333, lat0, lat0-δ, lat0+δ, 150000, 250000)
+
+			for lon := -5.0; lon <= 15; lon += 2.5 {
+				for lat := lat0 - 8; lat <= lat0+8; lat += 2 {
+					e1, n1 := sp1.Projection.FromLonLat(lon, lat, sp1.Datum)
+					e2, n2 := sp2.Projection.FromLonLat(lon, lat, sp2.Datum)
+
+					if math.Abs(e1-e2) > 1e-3 || math.Abs(n1-n2) > 1e-3 {
+						t.Fatal(lat0, δ, lon, lat, e1-e2, n1-n2)
+					}
+
+					lon2, lat2 := sp1.Projection.ToLonLat(e1, n1, sp1.Datum)
+					if math.Abs(lon2-lon) > 1e-9 || math.Abs(lat2-lat) > 1e-9 {
+						t.Fatal(lat0, lon, lat, lon2, lat2)
+					}
+				}
+			}
+		}
+	}
+
+	// the inverse is correct more than 90° from the central meridian.
+	crs := wgs84.WGS84().LambertConformalConic1SP(0, -60, 1, 0, 0)
+
+	for _, lon := range []float64{-170, -120, 120, 170} {
+		east, north := crs.Projection.FromLonLat(lon, -70, crs.Datum)
+
+		lon2, lat2 := crs.Projection.ToLonLat(east, north, crs.Datum)
+		if math.Abs(lon2-lon) > 1e-9 || math.Abs(lat2+70) > 1e-9 {
+			t.Fatal(lon, lon2, lat2)
+		}
+	}
+
+	def, err := wgs84.ToProj4String(wgs84.WGS84().LambertConformalConic1SP(-77, 18, 0.9996, 250000, 150000))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := wgs84.ParsePROJ(def)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if wgs84.Fingerprint(parsed) != wgs84.Fingerprint(wgs84.WGS84().LambertConformalConic1SP(-77, 18, 0.9996, 250000, 150000)) {
+		t.Fatal(def)
+	}
+}
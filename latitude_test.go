@@ -0,0 +1,116 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestGeodeticToGeocentricLat(t *testing.T) {
+	t.Parallel()
+
+	b := wgs84.A * (1 - 1/wgs84.Fi)
+
+	for lat := -90.0; lat <= 90; lat += 7.5 {
+		x, y, z := wgs84.WGS84LonLat().ToWGS84(30, lat, 0)
+		p := math.Hypot(x, y)
+
+		ψ := wgs84.GeodeticToGeocentricLat(lat, wgs84.Fi)
+		if want := math.Atan2(z, p) * 180 / math.Pi; math.Abs(ψ-want) > 1e-9 {
+			t.Fatal(lat, ψ, want)
+		}
+
+		β := wgs84.GeodeticToParametricLat(lat, wgs84.Fi)
+		if want := math.Atan2(z/b, p/wgs84.A) * 180 / math.Pi; math.Abs(β-want) > 1e-9 {
+			t.Fatal(lat, β, want)
+		}
+
+		if math.Abs(ψ) > math.Abs(β)+1e-12 || math.Abs(β) > math.Abs(lat)+1e-12 {
+			t.Fatal(lat, ψ, β)
+		}
+
+		if back := wgs84.GeocentricToGeodeticLat(ψ, wgs84.Fi); math.Abs(back-lat) > 1e-12 {
+			t.Fatal(lat, back)
+		}
+
+		if back := wgs84.ParametricToGeodeticLat(β, wgs84.Fi); math.Abs(back-lat) > 1e-12 {
+			t.Fatal(lat, back)
+		}
+
+		if ψ := wgs84.GeodeticToGeocentricLat(lat, math.Inf(1)); math.Abs(ψ-lat) > 1e-12 {
+			t.Fatal(lat, ψ)
+		}
+	}
+
+	if d := 45 - wgs84.GeodeticToGeocentricLat(45, wgs84.Fi); math.Abs(d-0.1924) > 1e-4 {
+		t.Fatal(d)
+	}
+}
+
+func TestParametricLatitude(t *testing.T) {
+	t.Parallel()
+
+	f := 1 / wgs84.Fi
+	e2 := 2*f - f*f
+
+	for lat := -1.5; lat <= 1.5; lat += 0.25 {
+		// The reduced latitude of Vincenty.
+		if β, want := wgs84.ParametricLatitude(lat, e2), math.Atan((1-f)*math.Tan(lat)); math.Abs(β-want) > 1e-15 {
+			t.Fatal(lat, β, want)
+		}
+
+		if back := wgs84.GeodesicLatitude(wgs84.ParametricLatitude(lat, e2), e2); math.Abs(back-lat) > 1e-15 {
+			t.Fatal(lat, back)
+		}
+	}
+
+	if β := wgs84.ParametricLatitude(math.Pi/2, e2); β != math.Pi/2 {
+		t.Fatal(β)
+	}
+}
+
+func TestAuthalicLatitude(t *testing.T) {
+	t.Parallel()
+
+	f := 1 / wgs84.Fi
+	e2 := 2*f - f*f
+
+	// The authalic latitude is 0.1283° smaller than the geodetic latitude at
+	// 45° on WGS84.
+	if d := 45 - wgs84.AuthalicLatitude(math.Pi/4, e2)*180/math.Pi; math.Abs(d-0.1283) > 1e-4 {
+		t.Fatal(d)
+	}
+
+	for lat := -math.Pi / 2; lat <= math.Pi/2+1e-9; lat += math.Pi / 36 {
+		β := wgs84.AuthalicLatitude(lat, e2)
+
+		if back := wgs84.InverseAuthalicLatitude(β, e2); math.Abs(back-lat) > 1e-12 {
+			t.Fatal(lat, β, back)
+		}
+
+		if β := wgs84.AuthalicLatitude(lat, 0); β != lat {
+			t.Fatal(lat, β)
+		}
+	}
+
+	// The area of the zone between the equator and a parallel is proportional
+	// to the sine of the authalic latitude. It's integrated numerically.
+	zone := func(φ1 float64) float64 {
+		const n = 1000
+
+		var sum float64
+
+		for i := 0; i < n; i++ {
+			φ := φ1 * (float64(i) + 0.5) / n
+			w := 1 - e2*math.Sin(φ)*math.Sin(φ)
+			sum += (1 - e2) / (w * w) * math.Cos(φ) * φ1 / n
+		}
+
+		return sum
+	}
+
+	if got, want := math.Sin(wgs84.AuthalicLatitude(math.Pi/6, e2)), zone(math.Pi/6)/zone(math.Pi/2); math.Abs(got-want) > 1e-6 {
+		t.Fatal(got, want)
+	}
+}
@@ -0,0 +1,97 @@
+package wgs84
+
+import (
+	"math"
+	"sync"
+)
+
+// LazyDatum provides a Datum that calls loader on its first use, like the
+// grid loaders with the file of a large grid.
+//
+// The loader is called once, even if the Datum is used by several goroutines.
+// If it fails, the Datum has a NaN Spheroid, Transformations to NaN and an
+// empty Area, and SafeTransform returns the error of the loader on every call.
+// The Body of the Datum is the earth.
+func LazyDatum(loader func() (Datum, error)) Datum {
+	l := &lazyDatum{loader: loader}
+
+	return Datum{
+		Spheroid:       l,
+		Transformation: l,
+		Area:           l,
+	}
+}
+
+type lazyDatum struct {
+	once   sync.Once
+	loader func() (Datum, error)
+	datum  Datum
+	err    error
+}
+
+func (l *lazyDatum) load() (Datum, error) {
+	l.once.Do(func() {
+		l.datum, l.err = l.loader()
+	})
+
+	return l.datum, l.err
+}
+
+// A returns the major axis of the loaded Datum.
+func (l *lazyDatum) A() float64 {
+	d, err := l.load()
+	if err != nil {
+		return math.NaN()
+	}
+
+	return d.A()
+}
+
+// Fi returns the inverse flattening of the loaded Datum.
+func (l *lazyDatum) Fi() float64 {
+	d, err := l.load()
+	if err != nil {
+		return math.NaN()
+	}
+
+	return d.Fi()
+}
+
+// Forward transforms geocentric coordinates to WGS84.
+func (l *lazyDatum) Forward(x, y, z float64) (x0, y0, z0 float64) {
+	d, err := l.load()
+	if err != nil {
+		return math.NaN(), math.NaN(), math.NaN()
+	}
+
+	return d.Forward(x, y, z)
+}
+
+// Inverse transforms geocentric coordinates from WGS84.
+func (l *lazyDatum) Inverse(x0, y0, z0 float64) (x, y, z float64) {
+	d, err := l.load()
+	if err != nil {
+		return math.NaN(), math.NaN(), math.NaN()
+	}
+
+	return d.Inverse(x0, y0, z0)
+}
+
+// Contains method is the implementation of the Area interface.
+func (l *lazyDatum) Contains(lon, lat float64) bool {
+	d, err := l.load()
+
+	return err == nil && d.Contains(lon, lat)
+}
+
+// loadErr returns the error of the loader of a LazyDatum of a
+// CoordinateReferenceSystem.
+func loadErr(crs CoordinateReferenceSystem) error {
+	if l, ok := datumOf(crs).Transformation.(*lazyDatum); ok {
+		_, err := l.load()
+
+		return err
+	}
+
+	return nil
+}
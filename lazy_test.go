@@ -0,0 +1,78 @@
+package wgs84_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestLazyDatum(t *testing.T) {
+	t.Parallel()
+
+	shift := func(lon, lat float64) (float64, float64) {
+		return 1.5 + 0.1*(lon-9), -0.8 + 0.05*(lat-46)
+	}
+
+	var calls int32
+
+	lazy := wgs84.LazyDatum(func() (wgs84.Datum, error) {
+		atomic.AddInt32(&calls, 1)
+
+		return wgs84.LoadMGIToETRS89Grid(bytes.NewReader(ntv2Grid(binary.LittleEndian, 9, 17.5, 46, 49.5, 1.0/12, shift)))
+	})
+
+	if calls != 0 {
+		t.Fatal(calls)
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			lon, lat, _, err := wgs84.SafeTransform(lazy.LonLat(), wgs84.ETRS89().LonLat())(16.37, 48.21, 0)
+			dlon, dlat := shift(16.37, 48.21)
+
+			if err != nil || math.Abs(lon-16.37-dlon/3600) > 1e-9 || math.Abs(lat-48.21-dlat/3600) > 1e-9 {
+				t.Error(lon, lat, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if calls != 1 || lazy.A() != (wgs84.Bessel{}).A() {
+		t.Fatal(calls, lazy.A())
+	}
+
+	var (
+		errLoad = errors.New("no grid")
+		failed  int32
+	)
+
+	broken := wgs84.LazyDatum(func() (wgs84.Datum, error) {
+		atomic.AddInt32(&failed, 1)
+
+		return wgs84.Datum{}, errLoad
+	})
+
+	for i := 0; i < 3; i++ {
+		_, _, _, err := wgs84.SafeTransform(wgs84.WGS84().LonLat(), broken.TransverseMercator(15, 0, 0.9996, 500000, 0))(16.37, 48.21, 0)
+		if !errors.Is(err, errLoad) {
+			t.Fatal(err)
+		}
+	}
+
+	if lon, _, _ := wgs84.Transform(broken.LonLat(), wgs84.WGS84().LonLat())(16.37, 48.21, 0); !math.IsNaN(lon) || failed != 1 {
+		t.Fatal(lon, failed)
+	}
+}
@@ -0,0 +1,51 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestNAD27(t *testing.T) {
+	t.Parallel()
+
+	// Meades Ranch, the origin of NAD27, and its NAD83 coordinate, which is
+	// within about 1 meter of WGS84.
+	lon27, lat27 := -(98 + 32.0/60 + 30.506/3600), 39+13.0/60+26.686/3600
+	lon83, lat83 := -(98 + 32.0/60 + 31.7454/3600), 39+13.0/60+26.7122/3600
+
+	lon, lat, _ := wgs84.Transform(wgs84.NAD27().LonLat(), wgs84.WGS84LonLat())(lon27, lat27, 0)
+	if d := wgs84.Distance(lon, lat, lon83, lat83, wgs84.WGS84()); d > 10 {
+		t.Fatal(lon, lat, d)
+	}
+
+	crs := wgs84.NAD27UTM(14, true)
+	if crs.EPSGCode() != 26714 || crs.Name() != "NAD27 / UTM zone 14N" {
+		t.Fatal(crs.EPSGCode(), crs.Name())
+	}
+
+	east, north, _ := wgs84.Transform(wgs84.NAD27().LonLat(), crs)(lon27, lat27, 0)
+	e, n := wgs84.NAD27().TransverseMercator(-99, 0, 0.9996, 500000, 0).Projection.FromLonLat(lon27, lat27, wgs84.Clarke1866{})
+
+	if math.Abs(east-e) > 1e-6 || math.Abs(north-n) > 1e-6 {
+		t.Fatal(east, north, e, n)
+	}
+
+	if !crs.Contains(lon27, lat27) || crs.Contains(-150, 61) {
+		t.Fatal(crs)
+	}
+
+	for _, c := range []struct {
+		zone     float64
+		northern bool
+	}{{14, false}, {0, true}, {23, true}, {60, true}, {14.5, true}} {
+		if crs := wgs84.NAD27UTM(c.zone, c.northern); crs.Projection != nil {
+			t.Fatal(c, crs)
+		}
+	}
+
+	if crs := wgs84.NAD27UTM(22, true); crs.EPSGCode() != 26722 {
+		t.Fatal(crs.EPSGCode())
+	}
+}
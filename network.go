@@ -0,0 +1,27 @@
+package wgs84
+
+import "fmt"
+
+// TransformNetwork transforms the station coordinates of a geodetic network
+// from one CoordinateReferenceSystem to another.
+//
+// All stations are transformed by the same chain of datum transformations.
+// Since these are similarity transformations, the relative geometry of the
+// network, its angles and distances, is preserved within the accuracy of
+// the datum transformation. The first station that can't be transformed
+// aborts the transformation.
+func TransformNetwork(from, to CoordinateReferenceSystem, stations [][3]float64) ([][3]float64, error) {
+	transform := SafeTransform(from, to)
+	result := make([][3]float64, len(stations))
+
+	for i, s := range stations {
+		a, b, c, err := transform(s[0], s[1], s[2])
+		if err != nil {
+			return nil, fmt.Errorf("station %d: %w", i, err)
+		}
+
+		result[i] = [3]float64{a, b, c}
+	}
+
+	return result, nil
+}
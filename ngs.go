@@ -0,0 +1,166 @@
+package wgs84
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidDatasheet is returned by ParseNGSDatasheet for malformed lines.
+var ErrInvalidDatasheet = errors.New("invalid NGS datasheet")
+
+// NGSBenchmark is a survey mark of a datasheet of the National Geodetic
+// Survey.
+//
+// Lon and Lat are in degrees of the horizontal datum of the datasheet,
+// usually NAD83(2011). EllipsoidalHeight and OrthometricHeight, the NAVD88
+// height, are in meters and NaN if the datasheet doesn't list them.
+type NGSBenchmark struct {
+	PID               string
+	Name              string
+	Lon, Lat          float64
+	EllipsoidalHeight float64
+	OrthometricHeight float64
+}
+
+// ParseNGSDatasheet returns the benchmarks of NGS datasheets, whose lines
+// start with a space and the PID, like
+//
+//	HV9395  DESIGNATION -  GPS 4
+//	HV9395  PID         -  HV9395
+//	HV9395* NAD 83(2011) POSITION- 38 52 31.43261(N) 077 13 39.95125(W)   ADJUSTED
+//	HV9395* NAD 83(2011) ELLIP HT-    47.874 (meters)        (06/27/12)   ADJUSTED
+//	HV9395* NAVD 88 ORTHO HEIGHT -    79.58  (meters)     261.1  (feet) GPS OBS
+//
+// Lines without a PID prefix and unknown records are ignored, so the whole
+// output of the NGS datasheet retrieval can be parsed.
+func ParseNGSDatasheet(r io.Reader) ([]NGSBenchmark, error) {
+	var (
+		marks []NGSBenchmark
+		index = map[string]int{}
+	)
+
+	scanner := bufio.NewScanner(r)
+
+	for n := 1; scanner.Scan(); n++ {
+		pid, record, ok := ngsRecord(scanner.Text())
+		if !ok {
+			continue
+		}
+
+		i, ok := index[pid]
+		if !ok {
+			i = len(marks)
+			index[pid] = i
+			marks = append(marks, NGSBenchmark{
+				PID:               pid,
+				Lon:               math.NaN(),
+				Lat:               math.NaN(),
+				EllipsoidalHeight: math.NaN(),
+				OrthometricHeight: math.NaN(),
+			})
+		}
+
+		if err := marks[i].parse(record); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidDatasheet, n, err)
+		}
+	}
+
+	return marks, scanner.Err()
+}
+
+// ngsRecord splits a datasheet line into the PID, two letters and four
+// digits, and the record after it.
+func ngsRecord(line string) (pid, record string, ok bool) {
+	if len(line) < 8 || line[0] != ' ' || (line[7] != ' ' && line[7] != '*') {
+		return "", "", false
+	}
+
+	pid = line[1:7]
+
+	for i, c := range pid {
+		if i < 2 && (c < 'A' || c > 'Z') || i >= 2 && (c < '0' || c > '9') {
+			return "", "", false
+		}
+	}
+
+	return pid, strings.TrimSpace(line[8:]), true
+}
+
+func (b *NGSBenchmark) parse(record string) error {
+	key, value, ok := strings.Cut(record, "-")
+	if !ok {
+		return nil
+	}
+
+	key = strings.TrimSpace(key)
+	fields := strings.Fields(value)
+
+	var err error
+
+	switch {
+	case key == "DESIGNATION":
+		b.Name = strings.TrimSpace(value)
+	case strings.HasSuffix(key, "POSITION"):
+		if len(fields) < 6 {
+			return fmt.Errorf("position %q", value)
+		}
+
+		if b.Lat, err = ngsAngle(fields[0:3]); err != nil {
+			return err
+		}
+
+		b.Lon, err = ngsAngle(fields[3:6])
+	case strings.HasSuffix(key, "ELLIP HT"):
+		b.EllipsoidalHeight, err = ngsHeight(fields)
+	case strings.HasPrefix(key, "NAVD 88 ORTHO HEIGHT"):
+		b.OrthometricHeight, err = ngsHeight(fields)
+	}
+
+	return err
+}
+
+// ngsAngle parses degrees, minutes and seconds with the hemisphere suffix,
+// like 077 13 39.95125(W).
+func ngsAngle(dms []string) (float64, error) {
+	sec, hemi, ok := strings.Cut(dms[2], "(")
+	if !ok {
+		return 0, fmt.Errorf("angle %q", strings.Join(dms, " "))
+	}
+
+	var v [3]float64
+
+	for i, s := range []string{dms[0], dms[1], sec} {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		v[i] = f
+	}
+
+	angle := v[0] + v[1]/60 + v[2]/3600
+
+	switch strings.TrimSuffix(hemi, ")") {
+	case "N", "E":
+		return angle, nil
+	case "S", "W":
+		return -angle, nil
+	default:
+		return 0, fmt.Errorf("hemisphere %q", hemi)
+	}
+}
+
+// ngsHeight parses a height in meters, like 47.874 (meters). Heights without
+// value, like "* (meters)", are NaN.
+func ngsHeight(fields []string) (float64, error) {
+	if len(fields) < 2 || fields[1] != "(meters)" {
+		return math.NaN(), nil
+	}
+
+	return strconv.ParseFloat(fields[0], 64)
+}
@@ -0,0 +1,75 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestNewZealandMapGrid(t *testing.T) {
+	t.Parallel()
+
+	crs := wgs84.NZMG()
+
+	// EPSG Guidance Note 7-2, New Zealand Map Grid. The geographic
+	// coordinates are rounded to 0.000001°, about 10 cm.
+	east, north := crs.Projection.FromLonLat(172.739194, -34.444066, crs.Datum)
+	if math.Abs(east-2487100.638) > 0.1 || math.Abs(north-6751049.719) > 0.1 {
+		t.Fatal(east, north)
+	}
+
+	if east, north := crs.Projection.FromLonLat(173, -41, crs.Datum); east != 2510000 || north != 6023150 {
+		t.Fatal(east, north)
+	}
+
+	const d = 1e-5
+
+	for lon := 166.5; lon <= 178.5; lon += 0.5 {
+		for lat := -47.25; lat <= -34.25; lat += 0.5 {
+			east, north := crs.Projection.FromLonLat(lon, lat, crs.Datum)
+
+			lon2, lat2 := crs.Projection.ToLonLat(east, north, crs.Datum)
+			if math.Abs(lon2-lon) > 1e-9 || math.Abs(lat2-lat) > 1e-9 {
+				t.Fatal(lon, lat, lon2, lat2)
+			}
+
+			// the projection is conformal, the scale along the meridian and
+			// the parallel is equal and they are perpendicular.
+			e1, n1 := crs.Projection.FromLonLat(lon, lat+d, crs.Datum)
+			e2, n2 := crs.Projection.FromLonLat(lon+d, lat, crs.Datum)
+			φ := radian(lat)
+			ν := crs.Datum.A() / math.Sqrt(1-0.006722670022*math.Pow(math.Sin(φ), 2))
+			ρ := ν * (1 - 0.006722670022) / (1 - 0.006722670022*math.Pow(math.Sin(φ), 2))
+
+			h := math.Hypot(e1-east, n1-north) / (ρ * radian(d))
+			k := math.Hypot(e2-east, n2-north) / (ν * math.Cos(φ) * radian(d))
+			cos := ((e1-east)*(e2-east) + (n1-north)*(n2-north)) / math.Hypot(e1-east, n1-north) / math.Hypot(e2-east, n2-north)
+
+			if math.Abs(h-k) > 1e-5 || math.Abs(cos) > 1e-5 {
+				t.Fatal(lon, lat, h, k, cos)
+			}
+		}
+	}
+}
+
+func TestNZTM2000(t *testing.T) {
+	t.Parallel()
+
+	crs := wgs84.NZTM2000()
+
+	if east, north := crs.Projection.FromLonLat(173, 0, crs.Datum); east != 1600000 || north != 10000000 {
+		t.Fatal(east, north)
+	}
+
+	for lon := 167.0; lon <= 178; lon++ {
+		for lat := -47.0; lat <= -35; lat++ {
+			east, north, _ := crs.FromWGS84(wgs84.NZGD2000().LonLat().ToWGS84(lon, lat, 0))
+
+			lon2, lat2, _ := wgs84.Transform(crs, wgs84.NZGD2000().LonLat())(east, north, 0)
+			if math.Abs(lon2-lon) > 1e-9 || math.Abs(lat2-lat) > 1e-9 {
+				t.Fatal(lon, lat, lon2, lat2)
+			}
+		}
+	}
+}
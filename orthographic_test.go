@@ -0,0 +1,77 @@
+package wgs84_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestOrthographic(t *testing.T) {
+	t.Parallel()
+
+	crs := wgs84.WGS84().Orthographic(5, 55)
+	toXYZ := wgs84.Transform(wgs84.WGS84LonLat(), wgs84.WGS84XYZ())
+	x0, y0, z0 := toXYZ(5, 55, 0)
+	φ0, λ0 := radian(55), radian(5)
+	east := [3]float64{-math.Sin(λ0), math.Cos(λ0), 0}
+	north := [3]float64{-math.Sin(φ0) * math.Cos(λ0), -math.Sin(φ0) * math.Sin(λ0), math.Cos(φ0)}
+
+	// the projection is the view of the geocentric coordinates along the
+	// normal of the natural origin.
+	for _, p := range [][2]float64{{2, 50}, {5, 55}, {-40, 30}, {60, 80}, {95, 40}, {5, 90}, {5, -34.9}} {
+		x, y, z := toXYZ(p[0], p[1], 0)
+		d := [3]float64{x - x0, y - y0, z - z0}
+		wantE := d[0]*east[0] + d[1]*east[1] + d[2]*east[2]
+		wantN := d[0]*north[0] + d[1]*north[1] + d[2]*north[2]
+
+		e, n := crs.Projection.FromLonLat(p[0], p[1], crs.Datum)
+		if math.Abs(e-wantE) > 1e-6 || math.Abs(n-wantN) > 1e-6 {
+			t.Fatal(p, e, n, wantE, wantN)
+		}
+
+		lon, lat := crs.Projection.ToLonLat(e, n, crs.Datum)
+		if math.Abs(lon-p[0]) > 1e-9 || math.Abs(lat-p[1]) > 1e-9 {
+			t.Fatal(p, lon, lat)
+		}
+	}
+
+	if e, n := crs.Projection.FromLonLat(-175, -55, crs.Datum); !math.IsNaN(e) || !math.IsNaN(n) {
+		t.Fatal(e, n)
+	}
+
+	if lon, lat := crs.Projection.ToLonLat(7000000, 0, crs.Datum); !math.IsNaN(lon) || !math.IsNaN(lat) {
+		t.Fatal(lon, lat)
+	}
+
+	if _, _, _, err := wgs84.WGS84LonLat().SafeTo(crs)(-175, -55, 0); !errors.Is(err, wgs84.ErrOutOfBounds) {
+		t.Fatal(err)
+	}
+
+	if _, _, _, err := crs.SafeTo(wgs84.WGS84LonLat())(7000000, 0, 0); !errors.Is(err, wgs84.ErrOutOfBounds) {
+		t.Fatal(err)
+	}
+}
+
+func BenchmarkOrthographic(b *testing.B) {
+	crs := wgs84.WGS84().Orthographic(5, 55)
+
+	var s wgs84.Spheroid = crs.Datum
+
+	b.Run("FromLonLat", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			_, _ = crs.Projection.FromLonLat(2, 50, s)
+		}
+	})
+
+	b.Run("ToLonLat", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			_, _ = crs.Projection.ToLonLat(-214988.995, -551065.939, s)
+		}
+	})
+}
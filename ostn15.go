@@ -0,0 +1,205 @@
+package wgs84
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/wroge/wgs84/proj"
+)
+
+// GeoidModel is a model of the geoid, the reference surface of orthometric
+// heights.
+//
+// Undulation returns the height of the geoid above the spheroid in meters at
+// geographic coordinates in degrees, or NaN outside of the model. Orthometric
+// heights are ellipsoidal heights minus the Undulation.
+type GeoidModel interface {
+	Undulation(lon, lat float64) float64
+}
+
+// The OSTN15 grid covers 0 to 700 km easting and 0 to 1250 km northing of the
+// ETRS89 National Grid in 1 km steps.
+const (
+	ostn15Cols = 701
+	ostn15Rows = 1251
+)
+
+// ostn15 is the OSTN15 and OSGM15 grid. The shifts are added to ETRS89
+// National Grid coordinates to get OSGB36 National Grid coordinates and ODN
+// heights.
+type ostn15 struct {
+	se, sn, sg []float32
+}
+
+// LoadOSTN15 provides a Datum similar to OSGB36 with the OSTN15
+// transformation of the Ordnance Survey.
+//
+// It reads the published data file OSTN15_OSGM15_DataFile.txt, a CSV file with
+// the shifts of the ETRS89 National Grid coordinates of each 1 km grid node.
+// OSTN15 is not published in a binary format. The shifts are interpolated
+// bilinearly. Outside the grid the 7-parameter-Helmert-Transformation of
+// OSGB36 is used. Ellipsoidal heights are not changed.
+func LoadOSTN15(r io.Reader) (Datum, error) {
+	grid, err := readOSTN15(r)
+	if err != nil {
+		return Datum{}, err
+	}
+
+	d := OSGB36()
+	d.Transformation = ostn15Transformation{grid: grid, fallback: d.Transformation}
+
+	return d, nil
+}
+
+// LoadOSGM15 provides the OSGM15 GeoidModel of the Ordnance Survey for ETRS89
+// coordinates.
+//
+// It reads the same data file as LoadOSTN15 and returns the undulations of
+// the Ordnance Datum Newlyn and the other height datums of Great Britain. The
+// undulations are interpolated bilinearly and are NaN where the data file has
+// no height datum.
+func LoadOSGM15(r io.Reader) (GeoidModel, error) {
+	return readOSTN15(r)
+}
+
+func readOSTN15(r io.Reader) (*ostn15, error) {
+	g := &ostn15{
+		se: make([]float32, ostn15Cols*ostn15Rows),
+		sn: make([]float32, ostn15Cols*ostn15Rows),
+		sg: make([]float32, ostn15Cols*ostn15Rows),
+	}
+
+	for i := range g.se {
+		g.se[i], g.sn[i], g.sg[i] = float32(math.NaN()), float32(math.NaN()), float32(math.NaN())
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 7
+	reader.ReuseRecord = true
+
+	for n := 1; ; n++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return g, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidGrid, err)
+		}
+
+		var v [6]float64
+
+		for i := range v {
+			if v[i], err = strconv.ParseFloat(record[i+1], 64); err != nil {
+				break
+			}
+		}
+
+		if err != nil {
+			if n == 1 {
+				continue // header
+			}
+
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidGrid, n, err)
+		}
+
+		col, row := int(v[0]/1000), int(v[1]/1000)
+		if v[0] != float64(col*1000) || v[1] != float64(row*1000) ||
+			col < 0 || col >= ostn15Cols || row < 0 || row >= ostn15Rows {
+			return nil, fmt.Errorf("%w: line %d: node %v,%v", ErrInvalidGrid, n, v[0], v[1])
+		}
+
+		i := row*ostn15Cols + col
+		g.se[i], g.sn[i] = float32(v[2]), float32(v[3])
+
+		if v[5] != 0 {
+			g.sg[i] = float32(v[4])
+		}
+	}
+}
+
+// Undulation method is the implementation of the GeoidModel interface.
+func (g *ostn15) Undulation(lon, lat float64) float64 {
+	east, north := ostn15Projection.FromLonLat(lon, lat, GRS80{})
+
+	return g.interpolate(g.sg, east, north)
+}
+
+// shift returns the shifts from ETRS89 to OSGB36 National Grid coordinates.
+func (g *ostn15) shift(east, north float64) (se, sn float64, ok bool) {
+	se, sn = g.interpolate(g.se, east, north), g.interpolate(g.sn, east, north)
+
+	return se, sn, !math.IsNaN(se) && !math.IsNaN(sn)
+}
+
+func (g *ostn15) interpolate(v []float32, east, north float64) float64 {
+	x, y := east/1000, north/1000
+	col, row := int(math.Floor(x)), int(math.Floor(y))
+
+	if col < 0 || col >= ostn15Cols-1 || row < 0 || row >= ostn15Rows-1 {
+		return math.NaN()
+	}
+
+	fx, fy := x-float64(col), y-float64(row)
+	i := row*ostn15Cols + col
+
+	return (1-fy)*((1-fx)*float64(v[i])+fx*float64(v[i+1])) +
+		fy*((1-fx)*float64(v[i+ostn15Cols])+fx*float64(v[i+ostn15Cols+1]))
+}
+
+// ostn15Projection is the National Grid projection of OSTN15, used on the
+// GRS80 Spheroid for ETRS89 and on the Airy Spheroid for OSGB36.
+var ostn15Projection = proj.TransverseMercator{Lonf: -2, Latf: 49, Scale: 0.9996012717, Eastf: 400000, Northf: -100000}
+
+// ostn15Transformation transforms OSGB36 to ETRS89, which is taken as WGS84,
+// with OSTN15 and falls back to a Transformation outside the grid.
+type ostn15Transformation struct {
+	grid     *ostn15
+	fallback Transformation
+}
+
+// Forward transforms geocentric coordinates to WGS84.
+//
+// The ETRS89 coordinates are found by fixed-point iteration.
+func (t ostn15Transformation) Forward(x, y, z float64) (x0, y0, z0 float64) {
+	lon, lat, h := xyzToLonLat(x, y, z, Airy{}.A(), Airy{}.Fi())
+	east, north := ostn15Projection.FromLonLat(lon, lat, Airy{})
+	e, n := east, north
+
+	for i := 0; i < 10; i++ {
+		se, sn, ok := t.grid.shift(e, n)
+		if !ok {
+			return t.fallback.Forward(x, y, z)
+		}
+
+		done := math.Abs(east-se-e) < 1e-4 && math.Abs(north-sn-n) < 1e-4
+		e, n = east-se, north-sn
+
+		if done {
+			break
+		}
+	}
+
+	lon, lat = ostn15Projection.ToLonLat(e, n, GRS80{})
+
+	return lonLatToXYZ(lon, lat, h, GRS80{}.A(), GRS80{}.Fi())
+}
+
+// Inverse transforms geocentric coordinates from WGS84.
+func (t ostn15Transformation) Inverse(x0, y0, z0 float64) (x, y, z float64) {
+	lon, lat, h := xyzToLonLat(x0, y0, z0, GRS80{}.A(), GRS80{}.Fi())
+	east, north := ostn15Projection.FromLonLat(lon, lat, GRS80{})
+
+	se, sn, ok := t.grid.shift(east, north)
+	if !ok {
+		return t.fallback.Inverse(x0, y0, z0)
+	}
+
+	lon, lat = ostn15Projection.ToLonLat(east+se, north+sn, Airy{})
+
+	return lonLatToXYZ(lon, lat, h, Airy{}.A(), Airy{}.Fi())
+}
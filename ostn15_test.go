@@ -0,0 +1,74 @@
+package wgs84_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+// ostn15File returns the nodes around 651 km easting and 313 km northing of
+// an OSTN15_OSGM15_DataFile.txt with shifts that change linearly.
+func ostn15File() string {
+	var b strings.Builder
+
+	b.WriteString("Point_ID,ETRS89_Easting,ETRS89_Northing,ETRS89_OSGB36_EShift,ETRS89_OSGB36_NShift,ETRS89_ODN_HeightShift,Height_Datum_Flag\n")
+
+	for _, n := range [][2]int{{651, 313}, {652, 313}, {651, 314}, {652, 314}} {
+		fmt.Fprintf(&b, "%d,%d,%d,%.3f,%.3f,%.3f,1\n", n[0]+n[1]*701+1, n[0]*1000, n[1]*1000,
+			102+0.1*float64(n[0]-651), -78-0.2*float64(n[1]-313), 44+float64(n[0]-651))
+	}
+
+	return b.String()
+}
+
+func TestLoadOSTN15(t *testing.T) {
+	t.Parallel()
+
+	osgb36, err := wgs84.LoadOSTN15(strings.NewReader(ostn15File()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	etrs89 := wgs84.ETRS89().TransverseMercator(-2, 49, 0.9996012717, 400000, -100000)
+	grid := osgb36.TransverseMercator(-2, 49, 0.9996012717, 400000, -100000)
+
+	east, north, _ := wgs84.Transform(etrs89, grid)(651500, 313250, 0)
+	if math.Abs(east-651602.05) > 1e-3 || math.Abs(north-313171.95) > 1e-3 {
+		t.Fatal(east, north)
+	}
+
+	east, north, _ = wgs84.Transform(grid, etrs89)(east, north, 0)
+	if math.Abs(east-651500) > 1e-3 || math.Abs(north-313250) > 1e-3 {
+		t.Fatal(east, north)
+	}
+
+	east, north, _ = wgs84.Transform(wgs84.ETRS89().LonLat(), grid)(-1, 52, 0)
+	hEast, hNorth, _ := wgs84.Transform(wgs84.ETRS89().LonLat(), wgs84.OSGB36NationalGrid())(-1, 52, 0)
+
+	if east != hEast || north != hNorth {
+		t.Fatal(east, north, hEast, hNorth)
+	}
+
+	geoid, err := wgs84.LoadOSGM15(strings.NewReader(ostn15File()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lon, lat, _ := wgs84.Transform(etrs89, wgs84.ETRS89().LonLat())(651500, 313250, 0)
+	if n := geoid.Undulation(lon, lat); math.Abs(n-44.5) > 1e-3 {
+		t.Fatal(n)
+	}
+
+	if n := geoid.Undulation(-1, 52); !math.IsNaN(n) {
+		t.Fatal(n)
+	}
+
+	_, err = wgs84.LoadOSTN15(strings.NewReader("1,0,0,1,2,3\n"))
+	if !errors.Is(err, wgs84.ErrInvalidGrid) {
+		t.Fatal(err)
+	}
+}
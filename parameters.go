@@ -0,0 +1,170 @@
+package wgs84
+
+import (
+	"errors"
+
+	"github.com/wroge/wgs84/proj"
+)
+
+// ErrUnknownProjection is returned for Projections not implemented in this
+// package.
+var ErrUnknownProjection = errors.New("unknown projection")
+
+// ProjectionParameters returns the method name and the named parameters of
+// a Projection of this package.
+//
+// The parameter names match the arguments of the Datum methods, for example
+// TransverseMercator returns lonf, latf, scale, eastf and northf.
+func ProjectionParameters(p Projection) (method string, params map[string]float64, err error) {
+	switch p := p.(type) {
+	case proj.WebMercator:
+		return "WebMercator", map[string]float64{}, nil
+	case proj.TransverseMercator:
+		return "TransverseMercator", map[string]float64{
+			"lonf":   p.Lonf,
+			"latf":   p.Latf,
+			"scale":  p.Scale,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.LambertConformalConic2SP:
+		return "LambertConformalConic2SP", map[string]float64{
+			"lonf":   p.Lonf,
+			"latf":   p.Latf,
+			"lat1":   p.Lat1,
+			"lat2":   p.Lat2,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.LambertConformalConic1SP:
+		return "LambertConformalConic1SP", map[string]float64{
+			"lonf":   p.Lonf,
+			"latf":   p.Latf,
+			"scale":  p.Scale,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.AlbersEqualAreaConic:
+		return "AlbersEqualAreaConic", map[string]float64{
+			"lonf":   p.Lonf,
+			"latf":   p.Latf,
+			"lat1":   p.Lat1,
+			"lat2":   p.Lat2,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.LambertAzimuthalEqualArea:
+		return "LambertAzimuthalEqualArea", map[string]float64{
+			"lonf":   p.Lonf,
+			"latf":   p.Latf,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.PolarStereographic:
+		return "PolarStereographic", map[string]float64{
+			"lonf":   p.Lonf,
+			"latf":   p.Latf,
+			"scale":  p.Scale,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.ObliqueStereographic:
+		return "ObliqueStereographic", map[string]float64{
+			"lonf":   p.Lonf,
+			"latf":   p.Latf,
+			"scale":  p.Scale,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.HotineObliqueMercator:
+		method := "HotineObliqueMercator"
+		if p.NaturalOrigin {
+			method = "HotineObliqueMercatorA"
+		}
+
+		return method, map[string]float64{
+			"lonc":    p.Lonc,
+			"latc":    p.Latc,
+			"azimuth": p.Azimuth,
+			"gamma":   p.Gamma,
+			"scale":   p.Scale,
+			"eastf":   p.Eastf,
+			"northf":  p.Northf,
+		}, nil
+	case proj.Mercator:
+		return "Mercator", map[string]float64{
+			"lonf":   p.Lonf,
+			"scale":  p.Scale,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.Equirectangular:
+		return "Equirectangular", map[string]float64{
+			"lonf":   p.Lonf,
+			"latts":  p.Latts,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.Orthographic:
+		return "Orthographic", map[string]float64{
+			"lonf":   p.Lonf,
+			"latf":   p.Latf,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.AzimuthalEquidistant:
+		method := "AzimuthalEquidistant"
+
+		switch {
+		case p.Guam:
+			method = "GuamProjection"
+		case p.Modified:
+			method = "ModifiedAzimuthalEquidistant"
+		}
+
+		return method, map[string]float64{
+			"lonf":   p.Lonf,
+			"latf":   p.Latf,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.CassiniSoldner:
+		return "CassiniSoldner", map[string]float64{
+			"lonf":   p.Lonf,
+			"latf":   p.Latf,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.Polyconic:
+		return "Polyconic", map[string]float64{
+			"lonf":   p.Lonf,
+			"latf":   p.Latf,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.NewZealandMapGrid:
+		return "NewZealandMapGrid", map[string]float64{
+			"lonf":   p.Lonf,
+			"latf":   p.Latf,
+			"eastf":  p.Eastf,
+			"northf": p.Northf,
+		}, nil
+	case proj.Krovak:
+		method := "Krovak"
+		if p.SouthWest {
+			method = "KrovakSouthWest"
+		}
+
+		return method, map[string]float64{
+			"lonc":    p.Lonc,
+			"latc":    p.Latc,
+			"azimuth": p.Azimuth,
+			"latp":    p.Latp,
+			"scale":   p.Scale,
+			"eastf":   p.Eastf,
+			"northf":  p.Northf,
+		}, nil
+	default:
+		return "", nil, ErrUnknownProjection
+	}
+}
@@ -0,0 +1,90 @@
+package wgs84
+
+import "github.com/wroge/wgs84/datum"
+
+// MarsIAU2015 is the biaxial spheroid of Mars adopted by the IAU in 2015.
+type MarsIAU2015 = datum.MarsIAU2015
+
+// MarsDatum provides a Datum for the planet Mars.
+//
+// It's based on the MarsIAU2015 Spheroid with the major axis 3396190 and
+// the minor axis 3376200. SafeTransform returns ErrDifferentBodies for
+// transformations to Coordinate Reference Systems of the earth.
+func MarsDatum() Datum {
+	return Datum(datum.Mars())
+}
+
+// MarsGeographic is a geographic Coordinate Reference System of Mars with
+// planetographic latitudes, the geodetic latitudes on the MarsIAU2015
+// Spheroid, and longitudes positive to the east. Unlike the IAU
+// planetographic convention, the longitudes are not positive to the west.
+func MarsGeographic() GeographicReferenceSystem {
+	return MarsDatum().LonLat().withMetadata(0, "Mars (2015)")
+}
+
+// MarsXYZ is a geocentric Coordinate Reference System of Mars.
+func MarsXYZ() GeocentricReferenceSystem {
+	return MarsDatum().XYZ().withMetadata(0, "Mars (2015) / Ocentric")
+}
+
+// MoonIAU2015 is the spherical mean radius of the Moon adopted by the IAU in
+// 2015.
+type MoonIAU2015 = datum.MoonIAU2015
+
+// LunarDatum provides a Datum for the Moon.
+//
+// It's based on the MoonIAU2015 sphere with the radius 1737400.
+// SafeTransform returns ErrDifferentBodies for transformations to Coordinate
+// Reference Systems of the earth.
+func LunarDatum() Datum {
+	return Datum(datum.Moon())
+}
+
+// LunarXYZ is a geocentric Coordinate Reference System of the Moon.
+func LunarXYZ() GeocentricReferenceSystem {
+	return LunarDatum().XYZ().withMetadata(0, "Moon (2015) / Ocentric")
+}
+
+// LunarLonLat is a geographic Coordinate Reference System of the Moon.
+func LunarLonLat() GeographicReferenceSystem {
+	return LunarDatum().LonLat().withMetadata(0, "Moon (2015)")
+}
+
+// LunarPolarStereoNorth is a projected Coordinate Reference System of the
+// Moon for latitudes over 60 degrees.
+func LunarPolarStereoNorth() ProjectedReferenceSystem {
+	crs := LunarDatum().PolarStereographic(0, 90, 1, 0, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lat >= 60
+	})
+
+	return crs.withMetadata(0, "Moon (2015) / North Polar")
+}
+
+// LunarPolarStereoSouth is a projected Coordinate Reference System of the
+// Moon for latitudes below -60 degrees.
+func LunarPolarStereoSouth() ProjectedReferenceSystem {
+	crs := LunarDatum().PolarStereographic(0, -90, 1, 0, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lat <= -60
+	})
+
+	return crs.withMetadata(0, "Moon (2015) / South Polar")
+}
+
+// TitanMean is the sphere with the mean radius of Titan.
+type TitanMean = datum.TitanMean
+
+// TitanDatum provides a Datum for the Saturn moon Titan.
+//
+// It's based on the TitanMean sphere with the radius 2574730. SafeTransform
+// returns ErrDifferentBodies for transformations to Coordinate Reference
+// Systems of the earth.
+func TitanDatum() Datum {
+	return Datum(datum.Titan())
+}
+
+// TitanLonLat is a geographic Coordinate Reference System of Titan.
+func TitanLonLat() GeographicReferenceSystem {
+	return TitanDatum().LonLat().withMetadata(0, "Titan (2015)")
+}
@@ -0,0 +1,78 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestPolyconic(t *testing.T) {
+	t.Parallel()
+
+	crs := wgs84.BrazilPolyconic()
+	a, fi := crs.Datum.A(), crs.Datum.Fi()
+	e2 := 2/fi - 1/fi/fi
+
+	// on the equator the projection is Plate Carrée.
+	for _, lon := range []float64{-69, -54, -40.5, -39} {
+		east, north := crs.Projection.FromLonLat(lon, 0, crs.Datum)
+		if math.Abs(east-5000000-a*radian(lon+54)) > 1e-6 || north != 10000000 {
+			t.Fatal(lon, east, north)
+		}
+
+		lon2, lat2 := crs.Projection.ToLonLat(east, north, crs.Datum)
+		if math.Abs(lon2-lon) > 1e-12 || lat2 != 0 {
+			t.Fatal(lon, lon2, lat2)
+		}
+
+		east2, north2 := crs.Projection.FromLonLat(lon, 1e-10, crs.Datum)
+		if math.Abs(east2-east) > 1e-6 || math.Abs(north2-north) > 1e-4 {
+			t.Fatal(lon, east2-east, north2-north)
+		}
+	}
+
+	for lon := -69.0; lon <= -39; lon += 1.5 {
+		for lat := -35.0; lat <= 7; lat += 0.5 {
+			east, north := crs.Projection.FromLonLat(lon, lat, crs.Datum)
+
+			// each parallel is a circle of true scale around the central meridian.
+			φ := radian(lat)
+			ν := a / math.Sqrt(1-e2*math.Pow(math.Sin(φ), 2))
+			r := ν / math.Tan(φ)
+			_, center := crs.Projection.FromLonLat(-54, lat, crs.Datum)
+
+			if lat != 0 {
+				x, y := east-5000000, center+r-north
+				if math.Abs(math.Hypot(x, y)-math.Abs(r)) > 1e-3 ||
+					math.Abs(math.Atan2(x/r, y/r)*r-ν*math.Cos(φ)*radian(lon+54)) > 1e-3 {
+					t.Fatal(lon, lat, east, north)
+				}
+			}
+
+			lon2, lat2 := crs.Projection.ToLonLat(east, north, crs.Datum)
+			if math.Abs(lon2-lon) > 1e-9 || math.Abs(lat2-lat) > 1e-9 {
+				t.Fatal(lon, lat, lon2, lat2)
+			}
+
+			east2, north2 := crs.Projection.FromLonLat(lon2, lat2, crs.Datum)
+			if math.Abs(east2-east) > 1e-3 || math.Abs(north2-north) > 1e-3 {
+				t.Fatal(lon, lat, east2-east, north2-north)
+			}
+		}
+	}
+
+	def, err := wgs84.ToProj4String(crs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := wgs84.ParsePROJ(def)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if wgs84.Fingerprint(parsed) != wgs84.Fingerprint(crs) {
+		t.Fatal(def)
+	}
+}
@@ -0,0 +1,184 @@
+package proj
+
+import "math"
+
+// AzimuthalEquidistant is the ellipsoidal Azimuthal Equidistant projection.
+// The distances and azimuths of the geodesics from the natural origin are
+// preserved exactly, like +proj=aeqd.
+//
+// Lonf and Latf are the longitude and latitude of the natural origin in
+// degrees, Eastf and Northf the false easting and northing in meters. It is
+// valid for the whole spheroid except the antipode of the natural origin.
+//
+// If Modified is set, the series of the Modified Azimuthal Equidistant
+// projection (EPSG method 9832) is used, which is only valid within about
+// 800 km of the natural origin. If Guam is set, the Guam Projection (EPSG
+// method 9831), like +proj=aeqd +guam, is used instead, which is only valid
+// on Guam.
+type AzimuthalEquidistant struct {
+	Lonf, Latf, Eastf, Northf float64
+	Modified                  bool
+	Guam                      bool
+}
+
+// ToLonLat is the inverse projection of AzimuthalEquidistant.
+//
+// It solves the direct geodesic problem with the iterative formulae of
+// Vincenty.
+func (p AzimuthalEquidistant) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+
+	switch {
+	case p.Guam:
+		return p._guamInverse(east, north, sph)
+	case p.Modified:
+		return p._modifiedInverse(east, north, sph)
+	}
+
+	east -= p.Eastf
+	north -= p.Northf
+
+	dist := math.Hypot(east, north)
+	if dist == 0 {
+		return p.Lonf, p.Latf
+	}
+
+	lon, lat, _ = sph.ellipsoid().Direct(p.Lonf, p.Latf, degree(math.Atan2(east, north)), dist)
+
+	return lon, lat
+}
+
+// FromLonLat is the forward projection of AzimuthalEquidistant.
+//
+// It solves the inverse geodesic problem with the iterative formulae of
+// Vincenty.
+func (p AzimuthalEquidistant) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+
+	switch {
+	case p.Guam:
+		return p._guamForward(lon, lat, sph)
+	case p.Modified:
+		return p._modifiedForward(lon, lat, sph)
+	}
+
+	dist, az, _ := sph.ellipsoid().Inverse(p.Lonf, p.Latf, lon, lat)
+	if dist == 0 {
+		return p.Eastf, p.Northf
+	}
+
+	sinα, cosα := math.Sincos(radian(az))
+
+	return p.Eastf + dist*sinα, p.Northf + dist*cosα
+}
+
+func (p AzimuthalEquidistant) _modifiedForward(lon, lat float64, sph spheroid) (east, north float64) {
+	e, e2 := sph.e(), sph.e2()
+	φ1, φ, λ := radian(p.Latf), radian(lat), radian(lon-p.Lonf)
+	ν1 := sph.A() / math.Sqrt(1-e2*sin2(φ1))
+	ν := sph.A() / math.Sqrt(1-e2*sin2(φ))
+
+	ψ := math.Atan((1-e2)*math.Tan(φ) + e2*ν1*math.Sin(φ1)/(ν*math.Cos(φ)))
+	α := math.Atan2(math.Sin(λ), math.Cos(φ1)*math.Tan(ψ)-math.Sin(φ1)*math.Cos(λ))
+	g := e * math.Sin(φ1) / math.Sqrt(1-e2)
+	h := e * math.Cos(φ1) * math.Cos(α) / math.Sqrt(1-e2)
+
+	var σ float64
+	if math.Sin(α) == 0 {
+		σ = math.Copysign(math.Asin(math.Cos(φ1)*math.Sin(ψ)-math.Sin(φ1)*math.Cos(ψ)), math.Cos(α))
+	} else {
+		σ = math.Asin(math.Sin(λ) * math.Cos(ψ) / math.Sin(α))
+	}
+
+	h2 := h * h
+	c := ν1 * σ * (1 - σ*σ*h2*(1-h2)/6 + math.Pow(σ, 3)/8*g*h*(1-2*h2) +
+		math.Pow(σ, 4)/120*(h2*(4-7*h2)-3*g*g*(1-7*h2)) - math.Pow(σ, 5)/48*g*h)
+
+	return p.Eastf + c*math.Sin(α), p.Northf + c*math.Cos(α)
+}
+
+// _modifiedInverse is the series of the EPSG method, corrected by at most 10
+// iterations until the forward projection is within 0.001 mm.
+func (p AzimuthalEquidistant) _modifiedInverse(east, north float64, sph spheroid) (lon, lat float64) {
+	lon, lat = p._modifiedSeries(east, north, sph)
+
+	for i := 0; i < 10; i++ {
+		e, n := p._modifiedForward(lon, lat, sph)
+		if math.Hypot(e-east, n-north) < 1e-6 {
+			break
+		}
+
+		lon2, lat2 := p._modifiedSeries(e, n, sph)
+		lon0, lat0 := p._modifiedSeries(east, north, sph)
+		lon, lat = lon+lon0-lon2, lat+lat0-lat2
+	}
+
+	return lon, lat
+}
+
+func (p AzimuthalEquidistant) _modifiedSeries(east, north float64, sph spheroid) (lon, lat float64) {
+	e2 := sph.e2()
+	φ1 := radian(p.Latf)
+	ν1 := sph.A() / math.Sqrt(1-e2*sin2(φ1))
+	east -= p.Eastf
+	north -= p.Northf
+
+	c := math.Hypot(east, north)
+	α := math.Atan2(east, north)
+	a := -e2 * cos2(φ1) * cos2(α) / (1 - e2)
+	b := 3 * e2 * (1 - a) * math.Sin(φ1) * math.Cos(φ1) * math.Cos(α) / (1 - e2)
+	d := c / ν1
+	j := d - a*(1+a)*math.Pow(d, 3)/6 - b*(1+3*a)*math.Pow(d, 4)/24
+	k := 1 - a*j*j/2 - b*math.Pow(j, 3)/6
+	ψ := math.Asin(math.Sin(φ1)*math.Cos(j) + math.Cos(φ1)*math.Sin(j)*math.Cos(α))
+
+	φ := φ1
+	if math.Sin(ψ) != 0 {
+		φ = math.Atan((1 - e2*k*math.Sin(φ1)/math.Sin(ψ)) * math.Tan(ψ) / (1 - e2))
+	}
+
+	return p.Lonf + degree(math.Asin(math.Sin(α)*math.Sin(j)/math.Cos(ψ))), degree(φ)
+}
+
+func (p AzimuthalEquidistant) _guamForward(lon, lat float64, sph spheroid) (east, north float64) {
+	φ := radian(lat)
+	w := math.Sqrt(1 - sph.e2()*sin2(φ))
+	x := sph.A() * radian(lon-p.Lonf) * math.Cos(φ) / w
+	y := sph.ellipsoid().MeridianArc(lat) - sph.ellipsoid().MeridianArc(p.Latf) +
+		x*x*math.Tan(φ)*w/(2*sph.A())
+
+	return p.Eastf + x, p.Northf + y
+}
+
+// _guamInverse iterates the latitude of the EPSG method, starting at the
+// natural origin, until it changes by less than 1e-14 radians.
+func (p AzimuthalEquidistant) _guamInverse(east, north float64, sph spheroid) (lon, lat float64) {
+	x, y := east-p.Eastf, north-p.Northf
+	m0 := sph.ellipsoid().MeridianArc(p.Latf)
+	φ := radian(p.Latf)
+
+	for i := 0; i < 10; i++ {
+		m := m0 + y - x*x*math.Tan(φ)*math.Sqrt(1-sph.e2()*sin2(φ))/(2*sph.A())
+		φ1 := radian(sph.ellipsoid().InverseMeridianArc(m))
+
+		if math.Abs(φ1-φ) < 1e-14 {
+			φ = φ1
+
+			break
+		}
+
+		φ = φ1
+	}
+
+	λ := x * math.Sqrt(1-sph.e2()*sin2(φ)) / (sph.A() * math.Cos(φ))
+
+	return p.Lonf + degree(λ), degree(φ)
+}
+
+// Hemisphere reports whether a point in degrees is at most 90° from the
+// natural origin.
+func (p AzimuthalEquidistant) Hemisphere(lon, lat float64) bool {
+	φ0, φ, λ := radian(p.Latf), radian(lat), radian(lon-p.Lonf)
+
+	return math.Sin(φ0)*math.Sin(φ)+math.Cos(φ0)*math.Cos(φ)*math.Cos(λ) >= 0
+}
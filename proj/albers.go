@@ -0,0 +1,93 @@
+package proj
+
+import "math"
+
+// AlbersEqualAreaConic is the Albers Equal Area Conic projection.
+//
+// Lonf and Latf are the longitude and latitude of the false origin in
+// degrees, Lat1 and Lat2 the standard parallels in degrees, Eastf and Northf
+// the false easting and northing in meters.
+type AlbersEqualAreaConic struct {
+	Lonf, Latf, Lat1, Lat2, Eastf, Northf float64
+}
+
+// ToLonLat is the inverse projection of AlbersEqualAreaConic.
+//
+// If Lat1 and Lat2 are equal, the projection has a single standard parallel.
+// At a pole as the standard parallel it's the polar aspect of the Lambert
+// Azimuthal Equal Area projection.
+func (p AlbersEqualAreaConic) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	n := p._n(sph)
+	x, y := east-p.Eastf, p._rho(radian(p.Latf), sph)-north+p.Northf
+
+	// the cone opens to the south if n is negative.
+	if n < 0 {
+		x, y = -x, -y
+	}
+
+	ρi := math.Hypot(x, y)
+	qi := (p._C(sph) - ρi*ρi*n*n/sph.a2()) / n
+	θ := math.Atan2(x, y)
+
+	// coordinates beyond the pole are NaN.
+	switch qp := p._q(math.Pi/2, sph); {
+	case math.Abs(qi) > qp+1e-9:
+		return math.NaN(), math.NaN()
+	case math.Abs(qi) >= qp-1e-12:
+		return degree(radian(p.Lonf) + θ/n), math.Copysign(90, qi)
+	}
+
+	φ := math.Asin(qi / 2)
+
+	for i := 0; i < 20; i++ {
+		Δφ := math.Pow(1-sph.e2()*sin2(φ), 2) /
+			(2 * math.Cos(φ)) * (qi/(1-sph.e2()) -
+			math.Sin(φ)/(1-sph.e2()*sin2(φ)) +
+			1/(2*sph.e())*math.Log((1-sph.e()*math.Sin(φ))/(1+sph.e()*math.Sin(φ))))
+		φ += Δφ
+
+		if math.Abs(Δφ) < 1e-14 {
+			break
+		}
+	}
+
+	return degree(radian(p.Lonf) + θ/n), degree(φ)
+}
+
+// FromLonLat is the forward projection of AlbersEqualAreaConic.
+func (p AlbersEqualAreaConic) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	θ := p._n(sph) * (radian(lon) - radian(p.Lonf))
+	east = p.Eastf + p._rho(radian(lat), sph)*math.Sin(θ)
+	north = p.Northf + p._rho(radian(p.Latf), sph) - p._rho(radian(lat), sph)*math.Cos(θ)
+
+	return east, north
+}
+
+func (p AlbersEqualAreaConic) _m(φ float64, sph spheroid) float64 {
+	return math.Cos(φ) / math.Sqrt(1-sph.e2()*sin2(φ))
+}
+
+func (p AlbersEqualAreaConic) _q(φ float64, sph spheroid) float64 {
+	return (1 - sph.e2()) * (math.Sin(φ)/(1-sph.e2()*sin2(φ)) -
+		(1/(2*sph.e()))*math.Log((1-sph.e()*math.Sin(φ))/(1+sph.e()*math.Sin(φ))))
+}
+
+func (p AlbersEqualAreaConic) _n(sph spheroid) float64 {
+	if radian(p.Lat1) == radian(p.Lat2) {
+		return math.Sin(radian(p.Lat1))
+	}
+
+	return (p._m(radian(p.Lat1), sph)*p._m(radian(p.Lat1), sph) -
+		p._m(radian(p.Lat2), sph)*p._m(radian(p.Lat2), sph)) /
+		(p._q(radian(p.Lat2), sph) - p._q(radian(p.Lat1), sph))
+}
+
+func (p AlbersEqualAreaConic) _C(sph spheroid) float64 {
+	return p._m(radian(p.Lat1), sph)*p._m(radian(p.Lat1), sph) + p._n(sph)*p._q(radian(p.Lat1), sph)
+}
+
+func (p AlbersEqualAreaConic) _rho(φ float64, sph spheroid) float64 {
+	return sph.A() * math.Sqrt(p._C(sph)-p._n(sph)*p._q(φ, sph)) / p._n(sph)
+}
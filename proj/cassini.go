@@ -0,0 +1,66 @@
+package proj
+
+import "math"
+
+// CassiniSoldner is the Cassini-Soldner projection (EPSG method 9806).
+//
+// Lonf and Latf are the longitude and latitude of the natural origin in
+// degrees, Eastf and Northf the false easting and northing in meters.
+type CassiniSoldner struct {
+	Lonf, Latf, Eastf, Northf float64
+}
+
+// ToLonLat is the inverse projection of CassiniSoldner.
+//
+// The series of the EPSG method is corrected by at most 10 iterations until
+// the forward projection is within 0.001 mm of the coordinates.
+func (p CassiniSoldner) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	lon, lat = p._inverse(east, north, sph)
+
+	for i := 0; i < 10; i++ {
+		e, n := p.FromLonLat(lon, lat, s)
+		if math.Hypot(e-east, n-north) < 1e-6 {
+			break
+		}
+
+		lon2, lat2 := p._inverse(e, n, sph)
+		lon0, lat0 := p._inverse(east, north, sph)
+		lon, lat = lon+lon0-lon2, lat+lat0-lat2
+	}
+
+	return lon, lat
+}
+
+// _inverse is the series of the EPSG method, which is exact to a millimeter
+// near the central meridian.
+func (p CassiniSoldner) _inverse(east, north float64, sph spheroid) (lon, lat float64) {
+	e2 := sph.e2()
+	m1 := sph.ellipsoid().MeridianArc(p.Latf) + north - p.Northf
+	φ1 := radian(sph.ellipsoid().InverseMeridianArc(m1))
+	t1 := tan2(φ1)
+	w := 1 - e2*sin2(φ1)
+	ν1 := sph.A() / math.Sqrt(w)
+	ρ1 := sph.A() * (1 - e2) / math.Pow(w, 1.5)
+	d := (east - p.Eastf) / ν1
+
+	φ := φ1 - ν1*math.Tan(φ1)/ρ1*(d*d/2-(1+3*t1)*math.Pow(d, 4)/24)
+	λ := (d - t1*math.Pow(d, 3)/3 + (1+3*t1)*t1*math.Pow(d, 5)/15) / math.Cos(φ1)
+
+	return p.Lonf + degree(λ), degree(φ)
+}
+
+// FromLonLat is the forward projection of CassiniSoldner.
+func (p CassiniSoldner) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	e2 := sph.e2()
+	φ := radian(lat)
+	a := radian(lon-p.Lonf) * math.Cos(φ)
+	t := tan2(φ)
+	c := e2 * cos2(φ) / (1 - e2)
+	ν := sph.A() / math.Sqrt(1-e2*sin2(φ))
+	x := sph.ellipsoid().MeridianArc(lat) - sph.ellipsoid().MeridianArc(p.Latf) +
+		ν*math.Tan(φ)*(a*a/2+(5-t+6*c)*math.Pow(a, 4)/24)
+
+	return p.Eastf + ν*(a-t*math.Pow(a, 3)/6-(8-t+8*c)*t*math.Pow(a, 5)/120), p.Northf + x
+}
@@ -0,0 +1,59 @@
+package proj
+
+import "math"
+
+// Equirectangular is the Equidistant Cylindrical projection, also known as
+// Plate Carrée, with the formulae of the sphere on the major axis of the
+// spheroid like +proj=eqc.
+//
+// Lonf is the central meridian and Latts the standard parallel in degrees,
+// Eastf and Northf the false easting and northing in meters.
+type Equirectangular struct {
+	Lonf, Latts, Eastf, Northf float64
+}
+
+// ToLonLat is the inverse projection of Equirectangular.
+func (p Equirectangular) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	return p.Lonf + degree((east-p.Eastf)/(s.A()*math.Cos(radian(p.Latts)))), degree((north - p.Northf) / s.A())
+}
+
+// FromLonLat is the forward projection of Equirectangular.
+func (p Equirectangular) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	return p.Eastf + s.A()*math.Cos(radian(p.Latts))*radian(lon-p.Lonf), p.Northf + s.A()*radian(lat)
+}
+
+// Mercator is the ellipsoidal Mercator projection (variant A).
+//
+// Lonf is the central meridian in degrees, Scale the scale factor on the
+// equator, Eastf and Northf the false easting and northing in meters.
+type Mercator struct {
+	Lonf, Scale, Eastf, Northf float64
+}
+
+// ToLonLat is the inverse projection of Mercator.
+func (p Mercator) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	e := sph.e()
+	t := math.Exp((p.Northf - north) / (sph.A() * p.Scale))
+	φ := math.Pi/2 - 2*math.Atan(t)
+
+	for i := 0; i < 15; i++ {
+		next := math.Pi/2 - 2*math.Atan(t*math.Pow((1-e*math.Sin(φ))/(1+e*math.Sin(φ)), e/2))
+		done := math.Abs(next-φ) < 1e-14
+		φ = next
+
+		if done {
+			break
+		}
+	}
+
+	return p.Lonf + degree((east-p.Eastf)/(sph.A()*p.Scale)), degree(φ)
+}
+
+// FromLonLat is the forward projection of Mercator.
+func (p Mercator) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+
+	return p.Eastf + sph.A()*p.Scale*radian(lon-p.Lonf),
+		p.Northf + sph.A()*p.Scale*radian(sph.ellipsoid().IsometricLatitude(lat))
+}
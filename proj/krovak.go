@@ -0,0 +1,96 @@
+package proj
+
+import "math"
+
+// Krovak is the Krovak oblique conic conformal projection.
+//
+// Lonc and Latc are the projection centre in degrees, Azimuth the azimuth of
+// the cone axis through the centre and Latp the pseudo standard parallel in
+// degrees, Scale the scale factor on the pseudo standard parallel.
+//
+// By default the coordinates are east and north, which are negative in the
+// Czech Republic and Slovakia (Krovak East North). If SouthWest is set, they
+// are westing and southing (Krovak), and Eastf and Northf are the false
+// westing and southing.
+type Krovak struct {
+	Lonc, Latc, Azimuth, Latp, Scale, Eastf, Northf float64
+	SouthWest                                       bool
+}
+
+// ToLonLat is the inverse projection of Krovak.
+//
+// The latitude is found by iteration to below a micrometer.
+func (p Krovak) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	c := p._constants(sph)
+	e := sph.e()
+	αc, φp := radian(p.Azimuth), radian(p.Latp)
+
+	xp, yp := p.Northf-north, p.Eastf-east
+	if p.SouthWest {
+		xp, yp = north-p.Northf, east-p.Eastf
+	}
+
+	r := math.Hypot(xp, yp)
+	θ := math.Atan2(yp, xp)
+	d := θ / c.n
+	t := 2 * (math.Atan(math.Pow(c.r0/r, 1/c.n)*math.Tan(math.Pi/4+φp/2)) - math.Pi/4)
+	u := math.Asin(math.Cos(αc)*math.Sin(t) - math.Sin(αc)*math.Cos(t)*math.Cos(d))
+	v := math.Asin(math.Cos(t) * math.Sin(d) / math.Cos(u))
+
+	φ := u
+	for i := 0; i < 20; i++ {
+		next := 2 * (math.Atan(math.Pow(c.t0, -1/c.b)*math.Pow(math.Tan(u/2+math.Pi/4), 1/c.b)*
+			math.Pow((1+e*math.Sin(φ))/(1-e*math.Sin(φ)), e/2)) - math.Pi/4)
+		done := math.Abs(next-φ) < 1e-14
+		φ = next
+
+		if done {
+			break
+		}
+	}
+
+	return p.Lonc - degree(v/c.b), degree(φ)
+}
+
+// FromLonLat is the forward projection of Krovak.
+func (p Krovak) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	c := p._constants(sph)
+	e := sph.e()
+	αc, φp := radian(p.Azimuth), radian(p.Latp)
+	φ := radian(lat)
+
+	u := 2 * (math.Atan(c.t0*math.Pow(math.Tan(φ/2+math.Pi/4), c.b)/
+		math.Pow((1+e*math.Sin(φ))/(1-e*math.Sin(φ)), e*c.b/2)) - math.Pi/4)
+	v := c.b * radian(p.Lonc-lon)
+	t := math.Asin(math.Cos(αc)*math.Sin(u) + math.Sin(αc)*math.Cos(u)*math.Cos(v))
+	d := math.Asin(math.Cos(u) * math.Sin(v) / math.Cos(t))
+	θ := c.n * d
+	r := c.r0 * math.Pow(math.Tan(math.Pi/4+φp/2), c.n) / math.Pow(math.Tan(t/2+math.Pi/4), c.n)
+	xp, yp := r*math.Cos(θ), r*math.Sin(θ)
+
+	if p.SouthWest {
+		return yp + p.Eastf, xp + p.Northf
+	}
+
+	return p.Eastf - yp, p.Northf - xp
+}
+
+type krovakConstants struct {
+	b, t0, n, r0 float64
+}
+
+func (p Krovak) _constants(sph spheroid) krovakConstants {
+	φc, φp := radian(p.Latc), radian(p.Latp)
+	e, e2 := sph.e(), sph.e2()
+
+	a := sph.A() * math.Sqrt(1-e2) / (1 - e2*sin2(φc))
+	b := math.Sqrt(1 + e2*math.Pow(math.Cos(φc), 4)/(1-e2))
+	γ0 := math.Asin(math.Sin(φc) / b)
+	t0 := math.Tan(math.Pi/4+γ0/2) * math.Pow((1+e*math.Sin(φc))/(1-e*math.Sin(φc)), e*b/2) /
+		math.Pow(math.Tan(math.Pi/4+φc/2), b)
+	n := math.Sin(φp)
+
+	return krovakConstants{b: b, t0: t0, n: n, r0: p.Scale * a / math.Tan(φp)}
+}
@@ -0,0 +1,104 @@
+package proj
+
+import "math"
+
+// LambertAzimuthalEqualArea is the Lambert Azimuthal Equal Area projection
+// in the oblique and the polar aspect.
+//
+// Latf and Lonf are the latitude and longitude of the natural origin in
+// degrees, Eastf and Northf the false easting and northing in meters. The
+// polar aspect is used if Latf is 90 or -90.
+type LambertAzimuthalEqualArea struct {
+	Latf, Lonf, Eastf, Northf float64
+}
+
+// ToLonLat is the inverse projection of LambertAzimuthalEqualArea.
+func (p LambertAzimuthalEqualArea) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	east -= p.Eastf
+	north -= p.Northf
+	qp := p._q(math.Pi/2, sph)
+
+	if math.Abs(p.Latf) == 90 {
+		ρ := math.Hypot(east, north)
+		β := math.Asin(math.Max(-1, 1-ρ*ρ/(sph.A()*sph.A()*qp)))
+
+		if p.Latf < 0 {
+			return p.Lonf + degree(math.Atan2(east, north)), -degree(p._latitude(β, sph))
+		}
+
+		return p.Lonf + degree(math.Atan2(east, -north)), degree(p._latitude(β, sph))
+	}
+
+	rq, d, β0 := p._oblique(sph)
+	ρ := math.Hypot(east/d, d*north)
+
+	if ρ == 0 {
+		return p.Lonf, p.Latf
+	}
+
+	c := 2 * math.Asin(ρ/(2*rq))
+	β := math.Asin(math.Cos(c)*math.Sin(β0) + d*north*math.Sin(c)*math.Cos(β0)/ρ)
+	λ := math.Atan2(east*math.Sin(c), d*ρ*math.Cos(β0)*math.Cos(c)-d*d*north*math.Sin(β0)*math.Sin(c))
+
+	return p.Lonf + degree(λ), degree(p._latitude(β, sph))
+}
+
+// FromLonLat is the forward projection of LambertAzimuthalEqualArea.
+func (p LambertAzimuthalEqualArea) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	q := p._q(radian(lat), sph)
+	qp := p._q(math.Pi/2, sph)
+	λ := radian(lon - p.Lonf)
+
+	if p.Latf == 90 {
+		ρ := sph.A() * math.Sqrt(qp-q)
+
+		return p.Eastf + ρ*math.Sin(λ), p.Northf - ρ*math.Cos(λ)
+	}
+
+	if p.Latf == -90 {
+		ρ := sph.A() * math.Sqrt(qp+q)
+
+		return p.Eastf + ρ*math.Sin(λ), p.Northf + ρ*math.Cos(λ)
+	}
+
+	rq, d, β0 := p._oblique(sph)
+	β := math.Asin(q / qp)
+	b := rq * math.Sqrt(2/(1+math.Sin(β0)*math.Sin(β)+math.Cos(β0)*math.Cos(β)*math.Cos(λ)))
+
+	return p.Eastf + b*d*math.Cos(β)*math.Sin(λ),
+		p.Northf + b/d*(math.Cos(β0)*math.Sin(β)-math.Sin(β0)*math.Cos(β)*math.Cos(λ))
+}
+
+// _oblique returns the radius rq of the authalic sphere, the factor d and the
+// authalic latitude β0 of the origin.
+func (p LambertAzimuthalEqualArea) _oblique(sph spheroid) (rq, d, β0 float64) {
+	φ0 := radian(p.Latf)
+	qp := p._q(math.Pi/2, sph)
+
+	rq = sph.A() * math.Sqrt(qp/2)
+	β0 = math.Asin(p._q(φ0, sph) / qp)
+	d = sph.A() * (math.Cos(φ0) / math.Sqrt(1-sph.e2()*sin2(φ0))) / (rq * math.Cos(β0))
+
+	return rq, d, β0
+}
+
+func (LambertAzimuthalEqualArea) _q(φ float64, sph spheroid) float64 {
+	e := sph.e()
+	if e == 0 {
+		return 2 * math.Sin(φ)
+	}
+
+	return (1 - sph.e2()) * (math.Sin(φ)/(1-sph.e2()*sin2(φ)) -
+		1/(2*e)*math.Log((1-e*math.Sin(φ))/(1+e*math.Sin(φ))))
+}
+
+// _latitude returns the latitude of an authalic latitude β in radians.
+func (LambertAzimuthalEqualArea) _latitude(β float64, sph spheroid) float64 {
+	e2, e4, e6 := sph.e2(), sph.e4(), sph.e6()
+
+	return β + (e2/3+31*e4/180+517*e6/5040)*math.Sin(2*β) +
+		(23*e4/360+251*e6/3780)*math.Sin(4*β) +
+		(761*e6/45360)*math.Sin(6*β)
+}
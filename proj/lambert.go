@@ -0,0 +1,132 @@
+package proj
+
+import "math"
+
+// LambertConformalConic2SP is the Lambert Conformal Conic projection with two
+// standard parallels.
+//
+// Lonf and Latf are the longitude and latitude of the false origin in
+// degrees, Lat1 and Lat2 the standard parallels in degrees, Eastf and Northf
+// the false easting and northing in meters.
+type LambertConformalConic2SP struct {
+	Lonf, Latf, Lat1, Lat2, Eastf, Northf float64
+}
+
+// ToLonLat is the inverse projection of LambertConformalConic2SP.
+func (p LambertConformalConic2SP) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	return p._cone(spheroid{a: s.A(), fi: s.Fi()}).toLonLat(east, north)
+}
+
+// FromLonLat is the forward projection of LambertConformalConic2SP.
+func (p LambertConformalConic2SP) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	return p._cone(spheroid{a: s.A(), fi: s.Fi()}).fromLonLat(lon, lat)
+}
+
+func (p LambertConformalConic2SP) _cone(sph spheroid) lambertCone {
+	φ1, φ2 := radian(p.Lat1), radian(p.Lat2)
+	n := math.Sin(φ1)
+
+	if φ1 != φ2 {
+		n = (math.Log(lambertM(φ1, sph)) - math.Log(lambertM(φ2, sph))) /
+			(math.Log(lambertT(φ1, sph)) - math.Log(lambertT(φ2, sph)))
+	}
+
+	c := sph.A() * lambertM(φ1, sph) / (n * math.Pow(lambertT(φ1, sph), n))
+
+	return lambertCone{
+		sph:    sph,
+		lonf:   p.Lonf,
+		eastf:  p.Eastf,
+		northf: p.Northf,
+		n:      n,
+		c:      c,
+		ρf:     c * math.Pow(lambertT(radian(p.Latf), sph), n),
+	}
+}
+
+// LambertConformalConic1SP is the Lambert Conformal Conic projection with one
+// standard parallel (EPSG method 9801).
+//
+// Lonf and Latf are the longitude and latitude of the natural origin in
+// degrees, Latf is the standard parallel and Scale the scale factor on it.
+// Eastf and Northf are the false easting and northing in meters.
+type LambertConformalConic1SP struct {
+	Lonf, Latf, Scale, Eastf, Northf float64
+}
+
+// ToLonLat is the inverse projection of LambertConformalConic1SP.
+func (p LambertConformalConic1SP) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	return p._cone(spheroid{a: s.A(), fi: s.Fi()}).toLonLat(east, north)
+}
+
+// FromLonLat is the forward projection of LambertConformalConic1SP.
+func (p LambertConformalConic1SP) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	return p._cone(spheroid{a: s.A(), fi: s.Fi()}).fromLonLat(lon, lat)
+}
+
+func (p LambertConformalConic1SP) _cone(sph spheroid) lambertCone {
+	φ0 := radian(p.Latf)
+	n := math.Sin(φ0)
+	c := sph.A() * p.Scale * lambertM(φ0, sph) / (n * math.Pow(lambertT(φ0, sph), n))
+
+	return lambertCone{
+		sph:    sph,
+		lonf:   p.Lonf,
+		eastf:  p.Eastf,
+		northf: p.Northf,
+		n:      n,
+		c:      c,
+		ρf:     c * math.Pow(lambertT(φ0, sph), n),
+	}
+}
+
+// lambertCone is the kernel of both variants of the Lambert Conformal Conic
+// projection. The radius of a parallel is c*t^n, ρf is the radius at the
+// origin.
+type lambertCone struct {
+	sph                 spheroid
+	lonf, eastf, northf float64
+	n, c, ρf            float64
+}
+
+func (k lambertCone) toLonLat(east, north float64) (lon, lat float64) {
+	x, y := east-k.eastf, k.ρf-(north-k.northf)
+
+	// the cone opens to the south if n is negative.
+	if k.n < 0 {
+		x, y = -x, -y
+	}
+
+	ρi := math.Copysign(math.Hypot(x, y), k.n)
+	ti := math.Pow(ρi/k.c, 1/k.n)
+	e := k.sph.e()
+	φ := math.Pi/2 - 2*math.Atan(ti)
+
+	for i := 0; i < 15; i++ {
+		next := math.Pi/2 - 2*math.Atan(ti*math.Pow((1-e*math.Sin(φ))/(1+e*math.Sin(φ)), e/2))
+		done := math.Abs(next-φ) < 1e-14
+		φ = next
+
+		if done {
+			break
+		}
+	}
+
+	return k.lonf + degree(math.Atan2(x, y)/k.n), degree(φ)
+}
+
+func (k lambertCone) fromLonLat(lon, lat float64) (east, north float64) {
+	θ := k.n * radian(lon-k.lonf)
+	ρ := k.c * math.Pow(lambertT(radian(lat), k.sph), k.n)
+
+	return k.eastf + ρ*math.Sin(θ), k.northf + k.ρf - ρ*math.Cos(θ)
+}
+
+func lambertT(φ float64, sph spheroid) float64 {
+	return math.Tan(math.Pi/4-φ/2) /
+		math.Pow((1-sph.e()*math.Sin(φ))/(1+sph.e()*math.Sin(φ)), sph.e()/2)
+}
+
+func lambertM(φ float64, sph spheroid) float64 {
+	return math.Cos(φ) / math.Sqrt(1-sph.e2()*sin2(φ))
+}
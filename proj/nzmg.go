@@ -0,0 +1,98 @@
+package proj
+
+import "math/cmplx"
+
+// NewZealandMapGrid is the New Zealand Map Grid projection (EPSG method 9811),
+// a complex polynomial of a conformal projection of the International1924
+// spheroid.
+//
+// Lonf and Latf are the longitude and latitude of the natural origin in
+// degrees, Eastf and Northf the false easting and northing in meters. The
+// polynomials are only accurate in New Zealand.
+type NewZealandMapGrid struct {
+	Lonf, Latf, Eastf, Northf float64
+}
+
+// The coefficients of the EPSG method.
+var (
+	nzmgA = [...]float64{
+		0.6399175073, -0.1358797613, 0.063294409, -0.02526853, 0.0117879,
+		-0.0055161, 0.0026906, -0.001333, 0.00067, -0.00034,
+	}
+	nzmgB = [...]complex128{
+		complex(0.7557853228, 0), complex(0.249204646, 0.003371507), complex(-0.001541739, 0.04105856),
+		complex(-0.10162907, 0.01727609), complex(-0.26623489, -0.36249218), complex(-0.6870983, -1.1651967),
+	}
+	nzmgC = [...]complex128{
+		complex(1.3231270439, 0), complex(-0.577245789, -0.007809598), complex(0.508307513, -0.112208952),
+		complex(-0.15094762, 0.18200602), complex(1.01418179, 1.64497696), complex(1.9660549, 2.5127645),
+	}
+	nzmgD = [...]float64{
+		1.5627014243, 0.5185406398, -0.03333098, -0.1052906, -0.0368594,
+		0.007317, 0.0122, 0.00394, -0.0013,
+	}
+)
+
+// ToLonLat is the inverse projection of NewZealandMapGrid.
+//
+// The series of the EPSG method is refined by Newton-Raphson iteration.
+func (p NewZealandMapGrid) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	z := complex((north-p.Northf)/s.A(), (east-p.Eastf)/s.A())
+
+	var ζ, zn complex128 = 0, 1
+
+	for _, c := range nzmgC {
+		zn *= z
+		ζ += c * zn
+	}
+
+	for i := 0; i < 10; i++ {
+		var num, den, ζn complex128 = z, 0, 1
+
+		for j, b := range nzmgB {
+			den += complex(float64(j+1), 0) * b * ζn
+			ζn *= ζ
+			num += complex(float64(j), 0) * b * ζn
+		}
+
+		next := num / den
+		done := cmplx.Abs(next-ζ) < 1e-15
+		ζ = next
+
+		if done {
+			break
+		}
+	}
+
+	var Δφ, ψn float64 = 0, 1
+
+	for _, d := range nzmgD {
+		ψn *= real(ζ)
+		Δφ += d * ψn
+	}
+
+	return p.Lonf + degree(imag(ζ)), p.Latf + Δφ*100000/3600
+}
+
+// FromLonLat is the forward projection of NewZealandMapGrid.
+func (p NewZealandMapGrid) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	Δφ := (lat - p.Latf) * 3600 / 100000
+
+	var Δψ, φn float64 = 0, 1
+
+	for _, a := range nzmgA {
+		φn *= Δφ
+		Δψ += a * φn
+	}
+
+	ζ := complex(Δψ, radian(lon-p.Lonf))
+
+	var z, ζn complex128 = 0, 1
+
+	for _, b := range nzmgB {
+		ζn *= ζ
+		z += b * ζn
+	}
+
+	return p.Eastf + s.A()*imag(z), p.Northf + s.A()*real(z)
+}
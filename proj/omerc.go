@@ -0,0 +1,112 @@
+package proj
+
+import "math"
+
+// HotineObliqueMercator is the Hotine Oblique Mercator projection, also known
+// as Rectified Skew Orthomorphic.
+//
+// Lonc and Latc are the projection centre in degrees, Azimuth the azimuth of
+// the initial line through the centre and Gamma the angle from the rectified
+// to the skew grid in degrees, Scale the scale factor on the initial line.
+// Eastf and Northf are the false easting and northing at the projection
+// centre (variant B). If NaturalOrigin is set, they are the false easting and
+// northing at the natural origin of the initial line (variant A).
+type HotineObliqueMercator struct {
+	Lonc, Latc, Azimuth, Gamma, Scale, Eastf, Northf float64
+	NaturalOrigin                                    bool
+}
+
+// ToLonLat is the inverse projection of HotineObliqueMercator.
+func (p HotineObliqueMercator) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	c := p._constants(sph)
+	γc := radian(p.Gamma)
+	east -= p.Eastf
+	north -= p.Northf
+
+	v := east*math.Cos(γc) - north*math.Sin(γc)
+	u := north*math.Cos(γc) + east*math.Sin(γc)
+
+	if !p.NaturalOrigin {
+		u += c.uc
+	}
+
+	q := math.Exp(-c.b * v / c.a)
+	ss := (q - 1/q) / 2
+	tt := (q + 1/q) / 2
+	vv := math.Sin(c.b * u / c.a)
+	uu := (vv*math.Cos(c.γ0) + ss*math.Sin(c.γ0)) / tt
+	t := math.Pow(c.h/math.Sqrt((1+uu)/(1-uu)), 1/c.b)
+	χ := math.Pi/2 - 2*math.Atan(t)
+
+	e2, e4, e6, e8 := sph.e2(), sph.e4(), sph.e6(), sph.e4()*sph.e4()
+	φ := χ + math.Sin(2*χ)*(e2/2+5*e4/24+e6/12+13*e8/360) +
+		math.Sin(4*χ)*(7*e4/48+29*e6/240+811*e8/11520) +
+		math.Sin(6*χ)*(7*e6/120+81*e8/1120) +
+		math.Sin(8*χ)*(4279*e8/161280)
+	λ := c.λ0 - math.Atan2(ss*math.Cos(c.γ0)-vv*math.Sin(c.γ0), math.Cos(c.b*u/c.a))/c.b
+
+	return degree(λ), degree(φ)
+}
+
+// FromLonLat is the forward projection of HotineObliqueMercator.
+func (p HotineObliqueMercator) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	c := p._constants(sph)
+	γc := radian(p.Gamma)
+	φ := radian(lat)
+	e := sph.e()
+
+	t := math.Tan(math.Pi/4-φ/2) / math.Pow((1-e*math.Sin(φ))/(1+e*math.Sin(φ)), e/2)
+	q := c.h / math.Pow(t, c.b)
+	ss := (q - 1/q) / 2
+	tt := (q + 1/q) / 2
+	bλ := c.b * (radian(lon) - c.λ0)
+	vv := math.Sin(bλ)
+	uu := (-vv*math.Cos(c.γ0) + ss*math.Sin(c.γ0)) / tt
+
+	v := c.a * math.Log((1-uu)/(1+uu)) / (2 * c.b)
+	u := c.a * math.Atan2(ss*math.Cos(c.γ0)+vv*math.Sin(c.γ0), math.Cos(bλ)) / c.b
+
+	if !p.NaturalOrigin {
+		u -= c.uc
+	}
+
+	return p.Eastf + v*math.Cos(γc) + u*math.Sin(γc), p.Northf + u*math.Cos(γc) - v*math.Sin(γc)
+}
+
+type omercConstants struct {
+	a, b, h, γ0, λ0, uc float64
+}
+
+func (p HotineObliqueMercator) _constants(sph spheroid) omercConstants {
+	φc, λc, αc := radian(p.Latc), radian(p.Lonc), radian(p.Azimuth)
+	e, e2 := sph.e(), sph.e2()
+	sign := math.Copysign(1, φc)
+
+	b := math.Sqrt(1 + e2*math.Pow(math.Cos(φc), 4)/(1-e2))
+	a := sph.A() * b * p.Scale * math.Sqrt(1-e2) / (1 - e2*sin2(φc))
+	t0 := math.Tan(math.Pi/4-φc/2) / math.Pow((1-e*math.Sin(φc))/(1+e*math.Sin(φc)), e/2)
+	d := b * math.Sqrt(1-e2) / (math.Cos(φc) * math.Sqrt(1-e2*sin2(φc)))
+	d2 := math.Max(d*d, 1)
+	f := d + math.Sqrt(d2-1)*sign
+	h := f * math.Pow(t0, b)
+	g := (f - 1/f) / 2
+	γ0 := math.Asin(math.Sin(αc) / d)
+	gt := g * math.Tan(γ0)
+
+	// g*tan(γ0) is ±1 for an azimuth of 90°, where asin is too sensitive to
+	// rounding errors.
+	if math.Abs(p.Azimuth) == 90 {
+		gt = math.Copysign(1, gt)
+	}
+
+	λ0 := λc - math.Asin(gt)/b
+
+	uc := a * (λc - λ0)
+	if math.Abs(p.Azimuth) != 90 {
+		uc = math.Abs(a/b*math.Atan2(math.Sqrt(d2-1), math.Cos(αc))) * sign
+	}
+
+	return omercConstants{a: a, b: b, h: h, γ0: γ0, λ0: λ0, uc: uc}
+}
@@ -0,0 +1,82 @@
+package proj
+
+import "math"
+
+// Orthographic is the ellipsoidal Orthographic projection (EPSG method 9840),
+// the view of the spheroid from an infinite distance above the natural
+// origin.
+//
+// Lonf and Latf are the longitude and latitude of the natural origin in
+// degrees, Eastf and Northf the false easting and northing in meters. Points
+// of the hidden hemisphere and coordinates outside the visible disc are NaN.
+type Orthographic struct {
+	Lonf, Latf, Eastf, Northf float64
+}
+
+// ToLonLat is the inverse projection of Orthographic.
+//
+// The coordinates are the intersection of the line of sight with the
+// spheroid nearest to the viewer. Coordinates outside the visible disc are
+// NaN.
+func (p Orthographic) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	e2 := sph.e2()
+	sinφ0, cosφ0 := math.Sincos(radian(p.Latf))
+	ν0 := sph.A() / math.Sqrt(1-e2*sinφ0*sinφ0)
+	east -= p.Eastf
+	n := north - p.Northf - e2*ν0*sinφ0*cosφ0
+
+	// x = t cosφ0 - n sinφ0, y = east and z = t sinφ0 + n cosφ0 on
+	// x² + y² + z²/(1-e²) = a², where t is the distance along the normal of
+	// the natural origin.
+	k := 1 / (1 - e2)
+	a := cosφ0*cosφ0 + k*sinφ0*sinφ0
+	b := 2 * (k - 1) * n * sinφ0 * cosφ0
+	c := n*n*(sinφ0*sinφ0+k*cosφ0*cosφ0) + east*east - sph.a2()
+	d := b*b - 4*a*c
+
+	if d < 0 || math.IsNaN(d) {
+		return math.NaN(), math.NaN()
+	}
+
+	var t float64
+
+	if q := -(b + math.Copysign(math.Sqrt(d), b)) / 2; q != 0 {
+		t = math.Max(q/a, c/q)
+	}
+
+	x, z := t*cosφ0-n*sinφ0, t*sinφ0+n*cosφ0
+
+	return p.Lonf + degree(math.Atan2(east, x)), degree(math.Atan2(z, (1-e2)*math.Hypot(x, east)))
+}
+
+// FromLonLat is the forward projection of Orthographic.
+func (p Orthographic) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	e2 := sph.e2()
+	φ, φ0, λ := radian(lat), radian(p.Latf), radian(lon-p.Lonf)
+
+	if !p.visible(φ, λ) {
+		return math.NaN(), math.NaN()
+	}
+
+	ν := sph.A() / math.Sqrt(1-e2*sin2(φ))
+	ν0 := sph.A() / math.Sqrt(1-e2*sin2(φ0))
+
+	return p.Eastf + ν*math.Cos(φ)*math.Sin(λ),
+		p.Northf + ν*(math.Sin(φ)*math.Cos(φ0)-math.Cos(φ)*math.Sin(φ0)*math.Cos(λ)) +
+			e2*(ν0*math.Sin(φ0)-ν*math.Sin(φ))*math.Cos(φ0)
+}
+
+// visible reports whether the normal of a point is at most 90° from the
+// normal of the natural origin.
+func (p Orthographic) visible(φ, λ float64) bool {
+	φ0 := radian(p.Latf)
+
+	return math.Sin(φ0)*math.Sin(φ)+math.Cos(φ0)*math.Cos(φ)*math.Cos(λ) >= -1e-12
+}
+
+// Visible reports whether a point in degrees is on the visible hemisphere.
+func (p Orthographic) Visible(lon, lat float64) bool {
+	return p.visible(radian(lat), radian(lon-p.Lonf))
+}
@@ -0,0 +1,68 @@
+package proj
+
+import "math"
+
+// Polyconic is the American Polyconic projection (EPSG method 9818).
+//
+// Lonf and Latf are the longitude and latitude of the natural origin in
+// degrees, Eastf and Northf the false easting and northing in meters. Each
+// parallel is a circle of true scale, the equator is projected like
+// Plate Carrée.
+type Polyconic struct {
+	Lonf, Latf, Eastf, Northf float64
+}
+
+// ToLonLat is the inverse projection of Polyconic.
+//
+// The latitude is found by the Newton-Raphson iteration of the EPSG method
+// until it changes less than 1e-14 radians.
+func (p Polyconic) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	e2 := sph.e2()
+	x := (east - p.Eastf) / sph.A()
+	a := (sph.ellipsoid().MeridianArc(p.Latf) + north - p.Northf) / sph.A()
+
+	if math.Abs(a) < 1e-15 {
+		return p.Lonf + degree(x), 0
+	}
+
+	b := a*a + x*x
+	φ := a
+
+	for i := 0; i < 20; i++ {
+		c := math.Sqrt(1-e2*sin2(φ)) * math.Tan(φ)
+		m := sph.ellipsoid().MeridianArc(degree(φ)) / sph.A()
+		dm := (1 - e2) / math.Pow(1-e2*sin2(φ), 1.5)
+		sin2φ := math.Sin(2 * φ)
+
+		Δφ := (a*(c*m+1) - m - (m*m+b)*c/2) /
+			(e2*sin2φ*(m*m+b-2*a*m)/(4*c) + (a-m)*(c*dm-2/sin2φ) - dm)
+		φ -= Δφ
+
+		if math.Abs(Δφ) < 1e-14 {
+			break
+		}
+	}
+
+	c := math.Sqrt(1-e2*sin2(φ)) * math.Tan(φ)
+
+	return p.Lonf + degree(math.Asin(x*c)/math.Sin(φ)), degree(φ)
+}
+
+// FromLonLat is the forward projection of Polyconic.
+func (p Polyconic) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	m0 := sph.ellipsoid().MeridianArc(p.Latf)
+	λ := radian(lon - p.Lonf)
+
+	if lat == 0 {
+		return p.Eastf + sph.A()*λ, p.Northf - m0
+	}
+
+	φ := radian(lat)
+	l := λ * math.Sin(φ)
+	ν := sph.A() / math.Sqrt(1-sph.e2()*sin2(φ))
+
+	return p.Eastf + ν/math.Tan(φ)*math.Sin(l),
+		p.Northf + sph.ellipsoid().MeridianArc(lat) - m0 + 2*ν/math.Tan(φ)*sin2(l/2)
+}
@@ -0,0 +1,111 @@
+// Package proj provides the map projections of the wgs84 package.
+//
+// The projections implement the Projection interface and can be used in a
+// ProjectedReferenceSystem of the wgs84 package. Additional projections can be
+// added by implementing the same interface.
+package proj
+
+import (
+	"math"
+
+	"github.com/wroge/wgs84/datum"
+	"github.com/wroge/wgs84/geod"
+)
+
+// Spheroid interface represents an ellipsoid of revolution used by
+// geodetic datums.
+//
+// It is specified by the major axis and the inverse flattening.
+type Spheroid = datum.Spheroid
+
+// Projection interface converts geographic coordinates of a Spheroid in
+// degrees to projected coordinates in meters and back.
+type Projection interface {
+	ToLonLat(east, north float64, s Spheroid) (lon, lat float64)
+	FromLonLat(lon, lat float64, s Spheroid) (east, north float64)
+}
+
+type spheroid struct {
+	a, fi float64
+}
+
+func (s spheroid) A() float64 {
+	return s.a
+}
+
+func (s spheroid) Fi() float64 {
+	return s.fi
+}
+
+func (s spheroid) ellipsoid() geod.Ellipsoid {
+	return geod.Ellipsoid{A: s.A(), Fi: s.Fi()}
+}
+
+func (s spheroid) a2() float64 {
+	return s.A() * s.A()
+}
+
+func (s spheroid) f() float64 {
+	return 1 / s.Fi()
+}
+
+func (s spheroid) f2() float64 {
+	return s.f() * s.f()
+}
+
+func (s spheroid) e2() float64 {
+	return 2/s.Fi() - s.f2()
+}
+
+func (s spheroid) e() float64 {
+	return math.Sqrt(s.e2())
+}
+
+func (s spheroid) e4() float64 {
+	return s.e2() * s.e2()
+}
+
+func (s spheroid) e6() float64 {
+	return s.e4() * s.e2()
+}
+
+// n returns the third flattening.
+func (s spheroid) n() float64 {
+	return s.f() / (2 - s.f())
+}
+
+func (s spheroid) ei() float64 {
+	return (1 - math.Sqrt(1-s.e2())) / (1 + math.Sqrt(1-s.e2()))
+}
+
+func (s spheroid) ei2() float64 {
+	return s.ei() * s.ei()
+}
+
+func (s spheroid) ei3() float64 {
+	return s.ei2() * s.ei()
+}
+
+func (s spheroid) ei4() float64 {
+	return s.ei3() * s.ei()
+}
+
+func sin2(east float64) float64 {
+	return math.Pow(math.Sin(east), 2)
+}
+
+func cos2(east float64) float64 {
+	return math.Pow(math.Cos(east), 2)
+}
+
+func tan2(east float64) float64 {
+	return math.Pow(math.Tan(east), 2)
+}
+
+func degree(r float64) float64 {
+	return r * 180 / math.Pi
+}
+
+func radian(d float64) float64 {
+	return d * math.Pi / 180
+}
@@ -0,0 +1,152 @@
+package proj
+
+import "math"
+
+// PolarStereographic is the Polar Stereographic projection with the natural
+// origin at a pole (variant A).
+//
+// Latf is the latitude of the natural origin, 90 or -90 degrees, Lonf the
+// longitude pointing down from the pole in degrees, Scale the scale factor at
+// the natural origin, Eastf and Northf the false easting and northing in
+// meters.
+type PolarStereographic struct {
+	Lonf, Latf, Scale, Eastf, Northf float64
+}
+
+// ToLonLat is the inverse projection of PolarStereographic.
+func (p PolarStereographic) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	east -= p.Eastf
+	north -= p.Northf
+
+	if p.Latf < 0 {
+		north = -north
+	}
+
+	t := math.Hypot(east, north) / (2 * sph.A() * p.Scale / p._k(sph))
+	φ := math.Pi/2 - 2*math.Atan(t)
+
+	for i := 0; i < 10; i++ {
+		φ = math.Pi/2 - 2*math.Atan(t*math.Pow((1-sph.e()*math.Sin(φ))/(1+sph.e()*math.Sin(φ)), sph.e()/2))
+	}
+
+	λ := radian(p.Lonf) + math.Atan2(east, -north)
+
+	if p.Latf < 0 {
+		return degree(λ), -degree(φ)
+	}
+
+	return degree(λ), degree(φ)
+}
+
+// FromLonLat is the forward projection of PolarStereographic.
+func (p PolarStereographic) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	φ := radian(lat)
+
+	if p.Latf < 0 {
+		φ = -φ
+	}
+
+	t := math.Tan(math.Pi/4-φ/2) / math.Pow((1-sph.e()*math.Sin(φ))/(1+sph.e()*math.Sin(φ)), sph.e()/2)
+	ρ := 2 * sph.A() * p.Scale * t / p._k(sph)
+	θ := radian(lon - p.Lonf)
+
+	if p.Latf < 0 {
+		return p.Eastf + ρ*math.Sin(θ), p.Northf + ρ*math.Cos(θ)
+	}
+
+	return p.Eastf + ρ*math.Sin(θ), p.Northf - ρ*math.Cos(θ)
+}
+
+func (PolarStereographic) _k(sph spheroid) float64 {
+	e := sph.e()
+
+	return math.Sqrt(math.Pow(1+e, 1+e) * math.Pow(1-e, 1-e))
+}
+
+// PolarStereographicScale returns the scale factor at the pole of a
+// PolarStereographic projection with the standard parallel lat (variant B).
+func PolarStereographicScale(lat float64, s Spheroid) float64 {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	φ := math.Abs(radian(lat))
+	e := sph.e()
+
+	m := math.Cos(φ) / math.Sqrt(1-sph.e2()*sin2(φ))
+	t := math.Tan(math.Pi/4-φ/2) / math.Pow((1-e*math.Sin(φ))/(1+e*math.Sin(φ)), e/2)
+
+	return m * PolarStereographic{}._k(sph) / (2 * t)
+}
+
+// ObliqueStereographic is the Oblique Stereographic projection (double
+// stereographic) over a conformal sphere.
+//
+// Lonf and Latf are the natural origin in degrees, Scale the scale factor at
+// the natural origin, Eastf and Northf the false easting and northing in
+// meters.
+type ObliqueStereographic struct {
+	Lonf, Latf, Scale, Eastf, Northf float64
+}
+
+// ToLonLat is the inverse projection of ObliqueStereographic.
+func (p ObliqueStereographic) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	r, n, c, χ0 := p._sphere(sph)
+	east -= p.Eastf
+	north -= p.Northf
+
+	g := 2 * r * p.Scale * math.Tan(math.Pi/4-χ0/2)
+	h := 4*r*p.Scale*math.Tan(χ0) + g
+	i := math.Atan(east / (h + north))
+	j := math.Atan(east/(g-north)) - i
+	χ := χ0 + 2*math.Atan((north-east*math.Tan(j/2))/(2*r*p.Scale))
+	Λ := j + 2*i
+
+	ψ := 0.5 * math.Log((1+math.Sin(χ))/(c*(1-math.Sin(χ)))) / n
+	φ := 2*math.Atan(math.Exp(ψ)) - math.Pi/2
+	e := sph.e()
+
+	for k := 0; k < 10; k++ {
+		ψi := math.Log(math.Tan(φ/2+math.Pi/4) * math.Pow((1-e*math.Sin(φ))/(1+e*math.Sin(φ)), e/2))
+		φ -= (ψi - ψ) * math.Cos(φ) * (1 - sph.e2()*sin2(φ)) / (1 - sph.e2())
+	}
+
+	return p.Lonf + degree(Λ/n), degree(φ)
+}
+
+// FromLonLat is the forward projection of ObliqueStereographic.
+func (p ObliqueStereographic) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	r, n, c, χ0 := p._sphere(sph)
+	φ := radian(lat)
+	e := sph.e()
+
+	w := c * math.Pow((1+math.Sin(φ))/(1-math.Sin(φ))*math.Pow((1-e*math.Sin(φ))/(1+e*math.Sin(φ)), e), n)
+	χ := math.Asin((w - 1) / (w + 1))
+	Λ := n * radian(lon-p.Lonf)
+
+	b := 1 + math.Sin(χ)*math.Sin(χ0) + math.Cos(χ)*math.Cos(χ0)*math.Cos(Λ)
+	east = p.Eastf + 2*r*p.Scale*math.Cos(χ)*math.Sin(Λ)/b
+	north = p.Northf + 2*r*p.Scale*(math.Sin(χ)*math.Cos(χ0)-math.Cos(χ)*math.Sin(χ0)*math.Cos(Λ))/b
+
+	return east, north
+}
+
+// _sphere returns the radius r, the longitude factor n, the constant c and
+// the conformal latitude χ0 of the origin on the conformal sphere.
+func (p ObliqueStereographic) _sphere(sph spheroid) (r, n, c, χ0 float64) {
+	φ0 := radian(p.Latf)
+	e := sph.e()
+	w := 1 - sph.e2()*sin2(φ0)
+
+	r = sph.A() * math.Sqrt(1-sph.e2()) / w
+	n = math.Sqrt(1 + sph.e2()*math.Pow(math.Cos(φ0), 4)/(1-sph.e2()))
+
+	w1 := math.Pow((1+math.Sin(φ0))/(1-math.Sin(φ0))*math.Pow((1-e*math.Sin(φ0))/(1+e*math.Sin(φ0)), e), n)
+	sinχ0 := (w1 - 1) / (w1 + 1)
+	c = (n + math.Sin(φ0)) * (1 - sinχ0) / ((n - math.Sin(φ0)) * (1 + sinχ0))
+	w2 := c * w1
+	χ0 = math.Asin((w2 - 1) / (w2 + 1))
+
+	return r, n, c, χ0
+}
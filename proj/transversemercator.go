@@ -0,0 +1,95 @@
+package proj
+
+import "math"
+
+// TransverseMercator is the Transverse Mercator projection.
+//
+// Lonf and Latf are the longitude and latitude of the natural origin in
+// degrees, Scale the scale factor at the natural origin, Eastf and Northf
+// the false easting and northing in meters.
+//
+// It uses the series of Krüger to the fourth order of the third flattening,
+// which are accurate to a millimeter within 4000 kilometers of the central
+// meridian.
+type TransverseMercator struct {
+	Lonf, Latf, Scale, Eastf, Northf float64
+}
+
+// ToLonLat is the inverse projection of TransverseMercator.
+func (p TransverseMercator) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	n := sph.n()
+	β := [4]float64{
+		n/2 - 2*n*n/3 + 37*n*n*n/96 - n*n*n*n/360,
+		n*n/48 + n*n*n/15 - 437*n*n*n*n/1440,
+		17*n*n*n/480 - 37*n*n*n*n/840,
+		4397 * n * n * n * n / 161280,
+	}
+	δ := [4]float64{
+		2*n - 2*n*n/3 - 2*n*n*n + 116*n*n*n*n/45,
+		7*n*n/3 - 8*n*n*n/5 - 227*n*n*n*n/45,
+		56*n*n*n/15 - 136*n*n*n*n/35,
+		4279 * n * n * n * n / 630,
+	}
+
+	_, ξ0 := p._ξη(radian(p.Latf), 0, sph)
+	ξ := (north-p.Northf)/(p.Scale*p._A(sph)) + ξ0
+	η := (east - p.Eastf) / (p.Scale * p._A(sph))
+	ξi, ηi := ξ, η
+
+	for j, b := range β {
+		k := float64(2 * (j + 1))
+		ξi -= b * math.Sin(k*ξ) * math.Cosh(k*η)
+		ηi -= b * math.Cos(k*ξ) * math.Sinh(k*η)
+	}
+
+	χ := math.Asin(math.Sin(ξi) / math.Cosh(ηi))
+	φ := χ
+
+	for j, d := range δ {
+		φ += d * math.Sin(float64(2*(j+1))*χ)
+	}
+
+	return p.Lonf + degree(math.Atan2(math.Sinh(ηi), math.Cos(ξi))), degree(φ)
+}
+
+// FromLonLat is the forward projection of TransverseMercator.
+func (p TransverseMercator) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	η, ξ := p._ξη(radian(lat), radian(lon-p.Lonf), sph)
+	_, ξ0 := p._ξη(radian(p.Latf), 0, sph)
+
+	return p.Eastf + p.Scale*p._A(sph)*η, p.Northf + p.Scale*p._A(sph)*(ξ-ξ0)
+}
+
+// _ξη returns the coordinates η and ξ on the unit sphere scaled by the
+// rectifying radius.
+func (TransverseMercator) _ξη(φ, λ float64, sph spheroid) (η, ξ float64) {
+	n := sph.n()
+	α := [4]float64{
+		n/2 - 2*n*n/3 + 5*n*n*n/16 + 41*n*n*n*n/180,
+		13*n*n/48 - 3*n*n*n/5 + 557*n*n*n*n/1440,
+		61*n*n*n/240 - 103*n*n*n*n/140,
+		49561 * n * n * n * n / 161280,
+	}
+
+	t := math.Sinh(math.Atanh(math.Sin(φ)) - sph.e()*math.Atanh(sph.e()*math.Sin(φ)))
+	ξi := math.Atan2(t, math.Cos(λ))
+	ηi := math.Atanh(math.Sin(λ) / math.Sqrt(1+t*t))
+	ξ, η = ξi, ηi
+
+	for j, a := range α {
+		k := float64(2 * (j + 1))
+		ξ += a * math.Sin(k*ξi) * math.Cosh(k*ηi)
+		η += a * math.Cos(k*ξi) * math.Sinh(k*ηi)
+	}
+
+	return η, ξ
+}
+
+// _A returns the rectifying radius.
+func (TransverseMercator) _A(sph spheroid) float64 {
+	n := sph.n()
+
+	return sph.A() / (1 + n) * (1 + n*n/4 + n*n*n*n/64)
+}
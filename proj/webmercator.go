@@ -0,0 +1,25 @@
+package proj
+
+import "math"
+
+// WebMercator is the Popular Visualisation Pseudo Mercator projection, which
+// uses the spherical Mercator formulae on the major axis of the spheroid.
+type WebMercator struct{}
+
+// ToLonLat is the inverse projection of WebMercator.
+func (p WebMercator) ToLonLat(east, north float64, s Spheroid) (lon, lat float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	lon = degree(east / sph.A())
+	lat = math.Atan(math.Exp(north/sph.A()))*degree(1)*2 - 90
+
+	return lon, lat
+}
+
+// FromLonLat is the forward projection of WebMercator.
+func (p WebMercator) FromLonLat(lon, lat float64, s Spheroid) (east, north float64) {
+	sph := spheroid{a: s.A(), fi: s.Fi()}
+	east = radian(lon) * sph.A()
+	north = math.Log(math.Tan(radian((90+lat)/2))) * sph.A()
+
+	return east, north
+}
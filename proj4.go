@@ -0,0 +1,536 @@
+package wgs84
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/wroge/wgs84/datum"
+	"github.com/wroge/wgs84/proj"
+)
+
+// ErrUnsupportedDefinition is returned for CRS definitions that can't be
+// parsed into a CoordinateReferenceSystem of this package.
+var ErrUnsupportedDefinition = errors.New("unsupported crs definition")
+
+// ParsePROJ parses a PROJ definition string like
+// "+proj=utm +zone=32 +ellps=GRS80 +units=m +no_defs".
+//
+// It supports the projections and ellipsoids of this package, the datums
+// WGS84, NAD83 and OSGB36 and 3- or 7-parameter towgs84 transformations.
+// The units are assumed to be meters.
+func ParsePROJ(def string) (CoordinateReferenceSystem, error) {
+	params := map[string]string{}
+
+	for _, field := range strings.Fields(def) {
+		key, value, _ := strings.Cut(strings.TrimPrefix(field, "+"), "=")
+		params[key] = value
+	}
+
+	if _, ok := params["nadgrids"]; ok && params["proj"] == "merc" {
+		delete(params, "b")
+		params["rf"] = strconv.FormatFloat(Fi, 'f', -1, 64)
+	}
+
+	num := func(key string, fallback float64) float64 {
+		v, err := strconv.ParseFloat(params[key], 64)
+		if err != nil {
+			return fallback
+		}
+
+		return v
+	}
+
+	d, err := parsePROJDatum(params, num)
+	if err != nil {
+		return nil, err
+	}
+
+	lonf, latf := num("lon_0", 0), num("lat_0", 0)
+	eastf, northf := num("x_0", 0), num("y_0", 0)
+
+	switch params["proj"] {
+	case "longlat", "latlong", "lonlat", "latlon":
+		return d.LonLat(), nil
+	case "geocent":
+		return d.XYZ(), nil
+	case "merc":
+		if _, ok := params["nadgrids"]; ok && num("lat_ts", 0) == 0 && num("k", 1) == 1 {
+			return d.WebMercator(), nil
+		}
+
+		scale := num("k_0", num("k", 1))
+		if _, ok := params["lat_ts"]; ok {
+			φ, e2 := radian(num("lat_ts", 0)), (2-1/d.Fi())/d.Fi()
+			scale = math.Cos(φ) / math.Sqrt(1-e2*sin2(φ))
+		}
+
+		return d.Mercator(lonf, scale, eastf, northf), nil
+	case "eqc":
+		return d.Equirectangular(lonf, num("lat_ts", 0), eastf, northf), nil
+	case "tmerc":
+		return d.TransverseMercator(lonf, latf, num("k_0", num("k", 1)), eastf, northf), nil
+	case "utm":
+		zone := num("zone", 0)
+		if zone < 1 || zone > 60 {
+			return nil, ErrUnsupportedDefinition
+		}
+
+		if _, ok := params["south"]; ok {
+			northf = 10000000
+		}
+
+		return d.TransverseMercator(zone*6-183, 0, 0.9996, 500000, northf), nil
+	case "lcc":
+		lat1 := num("lat_1", latf)
+		if _, ok := params["lat_2"]; !ok && lat1 == latf {
+			return d.LambertConformalConic1SP(lonf, latf, num("k_0", num("k", 1)), eastf, northf), nil
+		}
+
+		if num("k_0", num("k", 1)) == 1 {
+			return d.LambertConformalConic2SP(lonf, latf, lat1, num("lat_2", lat1), eastf, northf), nil
+		}
+	case "aea":
+		lat1 := num("lat_1", latf)
+
+		return d.AlbersEqualAreaConic(lonf, latf, lat1, num("lat_2", lat1), eastf, northf), nil
+	case "laea":
+		return d.LambertAzimuthalEqualArea(lonf, latf, eastf, northf), nil
+	case "stere":
+		if math.Abs(latf) == 90 {
+			scale := num("k_0", num("k", 1))
+			if _, ok := params["lat_ts"]; ok {
+				scale = proj.PolarStereographicScale(num("lat_ts", latf), d)
+			}
+
+			return d.PolarStereographic(lonf, latf, scale, eastf, northf), nil
+		}
+	case "omerc":
+		lonc, azimuth := num("lonc", lonf), num("alpha", 0)
+		gamma, scale := num("gamma", azimuth), num("k_0", num("k", 1))
+
+		if _, ok := params["no_uoff"]; ok {
+			return d.HotineObliqueMercatorA(lonc, latf, azimuth, gamma, scale, eastf, northf), nil
+		}
+
+		return d.HotineObliqueMercator(lonc, latf, azimuth, gamma, scale, eastf, northf), nil
+	case "ortho":
+		crs := d.Orthographic(lonf, latf)
+		crs.Projection = proj.Orthographic{Lonf: lonf, Latf: latf, Eastf: eastf, Northf: northf}
+
+		return crs, nil
+	case "aeqd":
+		if _, ok := params["guam"]; ok {
+			return d.GuamProjection(lonf, latf, eastf, northf), nil
+		}
+
+		return d.AzimuthalEquidistant(lonf, latf, eastf, northf), nil
+	case "cass":
+		return d.CassiniSoldner(lonf, latf, eastf, northf), nil
+	case "poly":
+		return d.Polyconic(lonf, latf, eastf, northf), nil
+	case "nzmg":
+		return d.NewZealandMapGrid(lonf, latf, eastf, northf), nil
+	case "krovak":
+		crs := d.Krovak(num("lon_0", 24.833333333333332), num("lat_0", 49.5), num("alpha", 30.288139752777777), num("lat_ts", 78.5),
+			num("k_0", num("k", 0.9999)), eastf, northf)
+
+		if _, ok := params["czech"]; ok || params["axis"] == "swu" {
+			p, _ := crs.Projection.(proj.Krovak)
+			p.SouthWest = true
+			crs.Projection = p
+		}
+
+		return crs, nil
+	case "sterea":
+		return d.Stereographic(lonf, latf, num("k_0", num("k", 1)), eastf, northf), nil
+	}
+
+	return nil, ErrUnsupportedDefinition
+}
+
+func parsePROJDatum(params map[string]string, num func(string, float64) float64) (Datum, error) {
+	d := Datum{Area: AreaFunc(nil)}
+
+	switch params["datum"] {
+	case "":
+	case "WGS84":
+		params["ellps"] = "WGS84"
+	case "NAD83":
+		params["ellps"] = "GRS80"
+	case "OSGB36":
+		params["ellps"] = "airy"
+		params["towgs84"] = "446.448,-125.157,542.06,0.15,0.247,0.842,-20.489"
+	default:
+		return d, ErrUnsupportedDefinition
+	}
+
+	switch params["ellps"] {
+	case "", "WGS84":
+		d.Spheroid = spheroid{a: A, fi: Fi}
+	case "GRS80":
+		d.Spheroid = GRS80{}
+	case "airy":
+		d.Spheroid = Airy{}
+	case "bessel":
+		d.Spheroid = Bessel{}
+	case "clrk66":
+		d.Spheroid = Clarke1866{}
+	case "intl":
+		d.Spheroid = International1924{}
+	case "krass":
+		d.Spheroid = Krassowsky1940{}
+	default:
+		return d, ErrUnsupportedDefinition
+	}
+
+	if r := num("R", 0); r > 0 {
+		d.Spheroid = spheroid{a: r, fi: math.Inf(1)}
+	}
+
+	if a := num("a", 0); a > 0 {
+		switch b := num("b", 0); {
+		case num("rf", 0) > 0:
+			d.Spheroid = spheroid{a: a, fi: num("rf", 0)}
+		case b > 0 && b < a:
+			d.Spheroid = spheroid{a: a, fi: a / (a - b)}
+		default:
+			return d, ErrUnsupportedDefinition
+		}
+	}
+
+	if towgs84, ok := params["towgs84"]; ok {
+		var p [7]float64
+
+		values := strings.Split(towgs84, ",")
+		if len(values) != 3 && len(values) != 7 {
+			return d, ErrUnsupportedDefinition
+		}
+
+		for i, v := range values {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return d, ErrUnsupportedDefinition
+			}
+
+			p[i] = f
+		}
+
+		if p != [7]float64{} {
+			d.Transformation = helmert{Tx: p[0], Ty: p[1], Tz: p[2], Rx: p[3], Ry: p[4], Rz: p[5], Ds: p[6]}
+		}
+	}
+
+	return d, nil
+}
+
+// ToProj4String returns the PROJ definition string of a
+// CoordinateReferenceSystem.
+//
+// It is the inverse of ParsePROJ. A TimeDependentHelmert is written as the
+// towgs84 parameters at its Epoch, since PROJ strings of a CRS can't hold
+// rates. ToPROJPipeline keeps the rates.
+func ToProj4String(crs CoordinateReferenceSystem) (string, error) {
+	if isNil(crs) {
+		return "", ErrNoCoordinateReferenceSystem
+	}
+
+	var (
+		d   Datum
+		def string
+	)
+
+	switch c := crs.(type) {
+	case GeocentricReferenceSystem:
+		d, def = c.Datum, "+proj=geocent"
+	case GeographicReferenceSystem:
+		d, def = c.Datum, "+proj=longlat"
+	case Geographic3DCRS:
+		d, def = c.Datum, "+proj=longlat"
+	case Geographic2DCRS:
+		d, def = c.Datum, "+proj=longlat"
+	case ProjectedReferenceSystem:
+		if _, ok := c.Projection.(proj.WebMercator); ok {
+			a := formatPROJ(c.Datum.A())
+
+			return "+proj=merc +a=" + a + " +b=" + a + " +lat_ts=0 +lon_0=0 +x_0=0 +y_0=0 +k=1" +
+				" +units=m +nadgrids=@null +wktext +no_defs", nil
+		}
+
+		method, params, err := ProjectionParameters(c.Projection)
+		if err != nil {
+			return "", err
+		}
+
+		def, err = projString(method, params)
+		if err != nil {
+			return "", err
+		}
+
+		d = c.Datum
+	default:
+		return "", ErrUnsupportedDefinition
+	}
+
+	towgs84, err := datumString(d)
+	if err != nil {
+		return "", err
+	}
+
+	if def == "+proj=longlat" {
+		return def + towgs84 + " +no_defs", nil
+	}
+
+	return def + towgs84 + " +units=m +no_defs", nil
+}
+
+func projString(method string, p map[string]float64) (string, error) {
+	switch method {
+	case "TransverseMercator":
+		return "+proj=tmerc +lat_0=" + formatPROJ(p["latf"]) + " +lon_0=" + formatPROJ(p["lonf"]) +
+			" +k=" + formatPROJ(p["scale"]) + " +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	case "LambertConformalConic2SP", "AlbersEqualAreaConic":
+		name := "lcc"
+		if method == "AlbersEqualAreaConic" {
+			name = "aea"
+		}
+
+		return "+proj=" + name + " +lat_0=" + formatPROJ(p["latf"]) + " +lon_0=" + formatPROJ(p["lonf"]) +
+			" +lat_1=" + formatPROJ(p["lat1"]) + " +lat_2=" + formatPROJ(p["lat2"]) +
+			" +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	case "LambertConformalConic1SP":
+		return "+proj=lcc +lat_1=" + formatPROJ(p["latf"]) + " +lat_0=" + formatPROJ(p["latf"]) + " +lon_0=" + formatPROJ(p["lonf"]) +
+			" +k_0=" + formatPROJ(p["scale"]) + " +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	case "PolarStereographic":
+		return "+proj=stere +lat_0=" + formatPROJ(p["latf"]) + " +lon_0=" + formatPROJ(p["lonf"]) +
+			" +k=" + formatPROJ(p["scale"]) + " +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	case "ObliqueStereographic":
+		return "+proj=sterea +lat_0=" + formatPROJ(p["latf"]) + " +lon_0=" + formatPROJ(p["lonf"]) +
+			" +k=" + formatPROJ(p["scale"]) + " +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	case "HotineObliqueMercator", "HotineObliqueMercatorA":
+		def := "+proj=omerc +lat_0=" + formatPROJ(p["latc"]) + " +lonc=" + formatPROJ(p["lonc"]) +
+			" +alpha=" + formatPROJ(p["azimuth"]) + " +gamma=" + formatPROJ(p["gamma"]) + " +k=" + formatPROJ(p["scale"]) +
+			" +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"])
+		if method == "HotineObliqueMercatorA" {
+			def += " +no_uoff"
+		}
+
+		return def, nil
+	case "Mercator":
+		return "+proj=merc +lon_0=" + formatPROJ(p["lonf"]) + " +k=" + formatPROJ(p["scale"]) +
+			" +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	case "Equirectangular":
+		return "+proj=eqc +lat_ts=" + formatPROJ(p["latts"]) + " +lon_0=" + formatPROJ(p["lonf"]) +
+			" +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	case "Orthographic":
+		return "+proj=ortho +lat_0=" + formatPROJ(p["latf"]) + " +lon_0=" + formatPROJ(p["lonf"]) +
+			" +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	case "AzimuthalEquidistant", "ModifiedAzimuthalEquidistant":
+		// PROJ has no Modified Azimuthal Equidistant, the exact projection
+		// agrees with it near the natural origin.
+		return "+proj=aeqd +lat_0=" + formatPROJ(p["latf"]) + " +lon_0=" + formatPROJ(p["lonf"]) +
+			" +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	case "GuamProjection":
+		return "+proj=aeqd +guam +lat_0=" + formatPROJ(p["latf"]) + " +lon_0=" + formatPROJ(p["lonf"]) +
+			" +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	case "CassiniSoldner":
+		return "+proj=cass +lat_0=" + formatPROJ(p["latf"]) + " +lon_0=" + formatPROJ(p["lonf"]) +
+			" +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	case "Polyconic":
+		return "+proj=poly +lat_0=" + formatPROJ(p["latf"]) + " +lon_0=" + formatPROJ(p["lonf"]) +
+			" +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	case "NewZealandMapGrid":
+		return "+proj=nzmg +lat_0=" + formatPROJ(p["latf"]) + " +lon_0=" + formatPROJ(p["lonf"]) +
+			" +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	case "Krovak", "KrovakSouthWest":
+		def := "+proj=krovak +lat_0=" + formatPROJ(p["latc"]) + " +lon_0=" + formatPROJ(p["lonc"]) +
+			" +alpha=" + formatPROJ(p["azimuth"]) + " +lat_ts=" + formatPROJ(p["latp"]) + " +k=" + formatPROJ(p["scale"]) +
+			" +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"])
+		if method == "KrovakSouthWest" {
+			def += " +czech"
+		}
+
+		return def, nil
+	case "LambertAzimuthalEqualArea":
+		return "+proj=laea +lat_0=" + formatPROJ(p["latf"]) + " +lon_0=" + formatPROJ(p["lonf"]) +
+			" +x_0=" + formatPROJ(p["eastf"]) + " +y_0=" + formatPROJ(p["northf"]), nil
+	default:
+		return "", ErrUnknownProjection
+	}
+}
+
+func datumString(d Datum) (string, error) {
+	s := ellipsoidString(d)
+
+	switch t := d.Transformation.(type) {
+	case nil:
+		if s == " +ellps=WGS84" {
+			return " +datum=WGS84", nil
+		}
+
+		return s + " +towgs84=0,0,0,0,0,0,0", nil
+	case helmert:
+		return s + towgs84String(t), nil
+	case datum.TimeDependentHelmert:
+		return s + towgs84String(t.At()), nil
+	default:
+		return "", ErrUnsupportedDefinition
+	}
+}
+
+func towgs84String(t helmert) string {
+	return " +towgs84=" + strings.Join([]string{
+		formatPROJ(t.Tx), formatPROJ(t.Ty), formatPROJ(t.Tz),
+		formatPROJ(t.Rx), formatPROJ(t.Ry), formatPROJ(t.Rz), formatPROJ(t.Ds),
+	}, ",")
+}
+
+func ellipsoidString(d Datum) string {
+	switch a, fi := d.A(), d.Fi(); {
+	case a == A && fi == Fi:
+		return " +ellps=WGS84"
+	case a == (GRS80{}).A() && fi == (GRS80{}).Fi():
+		return " +ellps=GRS80"
+	case a == (Airy{}).A() && fi == (Airy{}).Fi():
+		return " +ellps=airy"
+	case a == (Bessel{}).A() && fi == (Bessel{}).Fi():
+		return " +ellps=bessel"
+	case a == (Clarke1866{}).A() && fi == (Clarke1866{}).Fi():
+		return " +ellps=clrk66"
+	case a == (International1924{}).A() && fi == (International1924{}).Fi():
+		return " +ellps=intl"
+	case a == (Krassowsky1940{}).A() && fi == (Krassowsky1940{}).Fi():
+		return " +ellps=krass"
+	case math.IsInf(fi, 1):
+		return " +R=" + formatPROJ(a)
+	default:
+		return " +a=" + formatPROJ(a) + " +rf=" + formatPROJ(fi)
+	}
+}
+
+// ToPROJPipeline returns the PROJ pipeline string of the transformation
+// between two Coordinate Reference System's.
+//
+// The pipeline converts to geocentric coordinates, applies the Helmert
+// transformations of both Datums and converts to the target system, like
+// the Transform function. A TimeDependentHelmert becomes a Helmert step with
+// rates, t_epoch and t_obs.
+func ToPROJPipeline(from, to CoordinateReferenceSystem) (string, error) {
+	if isNil(from) || isNil(to) {
+		return "", ErrNoCoordinateReferenceSystem
+	}
+
+	fromSteps, err := pipelineSteps(from)
+	if err != nil {
+		return "", err
+	}
+
+	toSteps, err := pipelineSteps(to)
+	if err != nil {
+		return "", err
+	}
+
+	steps := []string{"+proj=pipeline"}
+
+	for i := len(fromSteps) - 1; i >= 0; i-- {
+		steps = append(steps, fromSteps[i].String(!fromSteps[i].inv))
+	}
+
+	for _, step := range toSteps {
+		steps = append(steps, step.String(step.inv))
+	}
+
+	return strings.Join(steps, " "), nil
+}
+
+type pipelineStep struct {
+	inv bool
+	def string
+}
+
+func (s pipelineStep) String(inv bool) string {
+	if inv {
+		return "+step +inv " + s.def
+	}
+
+	return "+step " + s.def
+}
+
+// pipelineSteps returns the steps from WGS84 geocentric coordinates to the
+// coordinates of a CoordinateReferenceSystem.
+func pipelineSteps(crs CoordinateReferenceSystem) ([]pipelineStep, error) {
+	var (
+		d    Datum
+		last string
+	)
+
+	switch c := crs.(type) {
+	case GeocentricReferenceSystem:
+		d = c.Datum
+	case GeographicReferenceSystem:
+		d, last = c.Datum, "+proj=unitconvert +xy_in=rad +xy_out=deg"
+	case Geographic3DCRS:
+		d, last = c.Datum, "+proj=unitconvert +xy_in=rad +xy_out=deg"
+	case Geographic2DCRS:
+		d, last = c.Datum, "+proj=unitconvert +xy_in=rad +xy_out=deg"
+	case ProjectedReferenceSystem:
+		d = c.Datum
+
+		if _, ok := c.Projection.(proj.WebMercator); ok {
+			a := formatPROJ(c.Datum.A())
+			last = "+proj=webmerc +a=" + a + " +b=" + a
+
+			break
+		}
+
+		method, params, err := ProjectionParameters(c.Projection)
+		if err != nil {
+			return nil, err
+		}
+
+		def, err := projString(method, params)
+		if err != nil {
+			return nil, err
+		}
+
+		last = def + ellipsoidString(d)
+	default:
+		return nil, ErrUnsupportedDefinition
+	}
+
+	var steps []pipelineStep
+
+	switch t := d.Transformation.(type) {
+	case nil:
+	case helmert:
+		steps = append(steps, pipelineStep{inv: true, def: helmertString(t) + " +convention=position_vector"})
+	case datum.TimeDependentHelmert:
+		r := t.Rates
+		def := helmertString(t.Helmert) + " +dx=" + formatPROJ(r.Tx) + " +dy=" + formatPROJ(r.Ty) + " +dz=" + formatPROJ(r.Tz) +
+			" +drx=" + formatPROJ(r.Rx) + " +dry=" + formatPROJ(r.Ry) + " +drz=" + formatPROJ(r.Rz) +
+			" +ds=" + formatPROJ(r.Ds) + " +t_epoch=" + formatPROJ(t.RefEpoch) + " +t_obs=" + formatPROJ(t.Epoch) +
+			" +convention=position_vector"
+		steps = append(steps, pipelineStep{inv: true, def: def})
+	default:
+		return nil, ErrUnsupportedDefinition
+	}
+
+	if last == "" {
+		return steps, nil
+	}
+
+	steps = append(steps, pipelineStep{inv: true, def: "+proj=cart" + ellipsoidString(d)})
+
+	return append(steps, pipelineStep{def: last}), nil
+}
+
+func helmertString(t helmert) string {
+	return "+proj=helmert +x=" + formatPROJ(t.Tx) + " +y=" + formatPROJ(t.Ty) + " +z=" + formatPROJ(t.Tz) +
+		" +rx=" + formatPROJ(t.Rx) + " +ry=" + formatPROJ(t.Ry) + " +rz=" + formatPROJ(t.Rz) +
+		" +s=" + formatPROJ(t.Ds)
+}
+
+func formatPROJ(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,194 @@
+// Package proto implements the transformation behind the TransformService of
+// wgs84.proto.
+//
+// This is not a gRPC server. To keep the wgs84 module free of dependencies,
+// the messages are plain Go types mirroring the messages of wgs84.proto and
+// the package doesn't import grpc. Code generated with protoc-gen-go and
+// protoc-gen-go-grpc goes to the go_package github.com/wroge/wgs84/proto/wgs84pb
+// of wgs84.proto, and the generated TransformServiceServer can delegate to
+// the Server by converting the messages.
+package proto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/wroge/wgs84"
+)
+
+// Coordinate is a coordinate of any CoordinateReferenceSystem.
+type Coordinate struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+// GetX returns X.
+func (c *Coordinate) GetX() float64 {
+	if c == nil {
+		return 0
+	}
+
+	return c.X
+}
+
+// GetY returns Y.
+func (c *Coordinate) GetY() float64 {
+	if c == nil {
+		return 0
+	}
+
+	return c.Y
+}
+
+// GetZ returns Z.
+func (c *Coordinate) GetZ() float64 {
+	if c == nil {
+		return 0
+	}
+
+	return c.Z
+}
+
+// TransformRequest holds coordinates and the EPSG-Codes to transform them
+// from and to.
+type TransformRequest struct {
+	FromEpsg    int32
+	ToEpsg      int32
+	Coordinates []*Coordinate
+}
+
+// GetFromEpsg returns FromEpsg.
+func (r *TransformRequest) GetFromEpsg() int32 {
+	if r == nil {
+		return 0
+	}
+
+	return r.FromEpsg
+}
+
+// GetToEpsg returns ToEpsg.
+func (r *TransformRequest) GetToEpsg() int32 {
+	if r == nil {
+		return 0
+	}
+
+	return r.ToEpsg
+}
+
+// GetCoordinates returns Coordinates.
+func (r *TransformRequest) GetCoordinates() []*Coordinate {
+	if r == nil {
+		return nil
+	}
+
+	return r.Coordinates
+}
+
+// TransformResponse holds the transformed coordinates.
+type TransformResponse struct {
+	Coordinates []*Coordinate
+}
+
+// GetCoordinates returns Coordinates.
+func (r *TransformResponse) GetCoordinates() []*Coordinate {
+	if r == nil {
+		return nil
+	}
+
+	return r.Coordinates
+}
+
+// TransformStreamServer is the server side of the TransformStream method.
+//
+// Context returns the context of the stream, which is canceled when the
+// client goes away.
+type TransformStreamServer interface {
+	Context() context.Context
+	Send(*TransformResponse) error
+	Recv() (*TransformRequest, error)
+}
+
+// Server implements the TransformService.
+//
+// The EPSG-Codes are looked up in the Repository, or with wgs84.FromEPSG if
+// it's nil. Unknown EPSG-Codes return an error wrapping
+// wgs84.ErrUnknownEPSGCode.
+type Server struct {
+	Repository *wgs84.Repository
+}
+
+// NewServer returns a Server with the EPSG Repository.
+func NewServer() *Server {
+	return &Server{}
+}
+
+// Transform transforms all coordinates of a request. It stops with the error
+// of the context when the context is canceled.
+func (s *Server) Transform(ctx context.Context, req *TransformRequest) (*TransformResponse, error) {
+	from, err := s.code(req.GetFromEpsg())
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.code(req.GetToEpsg())
+	if err != nil {
+		return nil, err
+	}
+
+	transform := wgs84.Transform(from, to)
+	res := &TransformResponse{
+		Coordinates: make([]*Coordinate, len(req.GetCoordinates())),
+	}
+
+	for i, c := range req.GetCoordinates() {
+		if i%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		x, y, z := transform(c.GetX(), c.GetY(), c.GetZ())
+		res.Coordinates[i] = &Coordinate{X: x, Y: y, Z: z}
+	}
+
+	return res, nil
+}
+
+func (s *Server) code(code int32) (wgs84.CoordinateReferenceSystem, error) {
+	if s.Repository == nil {
+		return wgs84.FromEPSG(int(code))
+	}
+
+	if crs := s.Repository.Code(int(code)); crs != nil {
+		return crs, nil
+	}
+
+	return nil, fmt.Errorf("%w: %d", wgs84.ErrUnknownEPSGCode, code)
+}
+
+// TransformStream transforms the coordinates of each request of a stream
+// until the client closes it.
+func (s *Server) TransformStream(stream TransformStreamServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		res, err := s.Transform(stream.Context(), req)
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package proto_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+	"github.com/wroge/wgs84/proto"
+)
+
+func TestServerTransform(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []*proto.Server{proto.NewServer(), {Repository: wgs84.EPSG()}} {
+		res, err := s.Transform(context.Background(), &proto.TransformRequest{
+			FromEpsg:    4326,
+			ToEpsg:      32632,
+			Coordinates: []*proto.Coordinate{{X: 9, Y: 0}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if c := res.GetCoordinates()[0]; math.Abs(c.GetX()-500000) > 1e-6 || math.Abs(c.GetY()) > 1e-6 {
+			t.Fatal(c)
+		}
+
+		if _, err := s.Transform(context.Background(), &proto.TransformRequest{FromEpsg: 4326, ToEpsg: 1}); !errors.Is(err, wgs84.ErrUnknownEPSGCode) {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestPulkovo1942GK(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		zone     float64
+		lon, lat float64
+	}{
+		{7, 37.62, 55.75},
+		{6, 30.31, 59.94},
+		{32, -172, 65},
+		{30, 179.5, 66},
+		{31, -176, 66},
+	} {
+		crs := wgs84.Pulkovo1942GK(c.zone)
+		if crs.EPSGCode() != 28400+int(c.zone) || !crs.Contains(c.lon, c.lat) {
+			t.Fatal(c.zone, crs.EPSGCode())
+		}
+
+		east, north, _ := wgs84.Transform(wgs84.Pulkovo1942LonLat(), crs)(c.lon, c.lat, 0)
+		if math.Floor(east/1000000) != c.zone || math.Abs(math.Mod(east, 1000000)-500000) > 200000 {
+			t.Fatal(c.zone, east, north)
+		}
+
+		lon, lat, _ := wgs84.Transform(crs, wgs84.Pulkovo1942LonLat())(east, north, 0)
+		if math.Abs(math.Remainder(lon-c.lon, 360)) > 1e-7 || math.Abs(lat-c.lat) > 1e-7 {
+			t.Fatal(c.zone, lon, lat)
+		}
+	}
+
+	if wgs84.Pulkovo1942GK(7).Contains(43, 55) || wgs84.Pulkovo1942GK(32).Contains(-160, 65) {
+		t.Fatal("area")
+	}
+
+	for _, zone := range []float64{3, 33, 7.5, math.NaN()} {
+		if crs := wgs84.Pulkovo1942GK(zone); crs.Projection != nil {
+			t.Fatal(zone, crs)
+		}
+	}
+
+	crs, err := wgs84.ParsePROJ("+proj=longlat +ellps=krass +towgs84=23.92,-141.27,-80.9,0,0.35,0.82,-0.12 +no_defs")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lonLat, ok := crs.(wgs84.GeographicReferenceSystem); !ok || lonLat.Datum.A() != 6378245 || lonLat.Datum.Fi() != 298.3 {
+		t.Fatal(crs)
+	}
+}
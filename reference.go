@@ -2,36 +2,65 @@ package wgs84
 
 import (
 	"errors"
+	"fmt"
+	"math"
+
+	"github.com/wroge/wgs84/datum"
+	"github.com/wroge/wgs84/proj"
 )
 
 // To provides the transformation of WGS84 geographic coordinates to another
 // Coordinate Reference System.
 func To(to CoordinateReferenceSystem) Func {
-	return LonLat().To(to)
+	return WGS84LonLat().To(to)
 }
 
 // From provides the transformation of coordinates from a Coordinate Reference
 // System to WGS84 geographic coordinates.
 func From(from CoordinateReferenceSystem) Func {
-	return LonLat().From(from)
+	return WGS84LonLat().From(from)
+}
+
+// WGS84XYZ is a geocentric Coordinate Reference System similar to
+// https://epsg.io/4978
+func WGS84XYZ() GeocentricReferenceSystem {
+	return WGS84().XYZ().withMetadata(4978, "WGS 84")
+}
+
+// WGS84LonLat is a geographic Coordinate Reference System similar to
+// https://epsg.io/4326
+func WGS84LonLat() GeographicReferenceSystem {
+	return WGS84().LonLat().withMetadata(4326, "WGS 84")
 }
 
 // XYZ is a geocentric Coordinate Reference System similar to
 // https://epsg.io/4978
+//
+// Deprecated: Use WGS84XYZ instead. The cmd/wgs84fix tool rewrites
+// existing calls.
 func XYZ() GeocentricReferenceSystem {
-	return WGS84().XYZ()
+	return WGS84XYZ()
 }
 
 // LonLat is a geographic Coordinate Reference System similar to
 // https://epsg.io/4326
+//
+// Deprecated: Use WGS84LonLat instead. The cmd/wgs84fix tool rewrites
+// existing calls.
 func LonLat() GeographicReferenceSystem {
-	return WGS84().LonLat()
+	return WGS84LonLat()
 }
 
 // WebMercator is a projected Coordinate Reference System similar to
 // https://epsg.io/3857
 func WebMercator() ProjectedReferenceSystem {
-	return WGS84().WebMercator()
+	return WGS84().WebMercator().withMetadata(3857, "WGS 84 / Pseudo-Mercator")
+}
+
+// PlateCarree is a projected Coordinate Reference System similar to
+// https://epsg.io/32662
+func PlateCarree() ProjectedReferenceSystem {
+	return WGS84().Equirectangular(0, 0, 0, 0).withMetadata(32662, "WGS 84 / Plate Carree")
 }
 
 // UTM represents projected Coordinate Reference System's similar to
@@ -52,7 +81,11 @@ func UTM(zone float64, northern bool) ProjectedReferenceSystem {
 		return lon >= zone*6-186 && lon <= zone*6-180 && lat <= 0 && lat >= -80
 	})
 
-	return crs
+	if northern {
+		return crs.withMetadata(32600+int(zone), fmt.Sprintf("WGS 84 / UTM zone %dN", int(zone)))
+	}
+
+	return crs.withMetadata(32700+int(zone), fmt.Sprintf("WGS 84 / UTM zone %dS", int(zone)))
 }
 
 // ETRS89UTM represents projected Coordinate Reference System's similar to
@@ -63,65 +96,143 @@ func ETRS89UTM(zone float64) ProjectedReferenceSystem {
 		return lon >= zone*6-186 && lon <= zone*6-180 && lat >= 0 && lat <= 84
 	})
 
-	return crs
+	return crs.withMetadata(25800+int(zone), fmt.Sprintf("ETRS89 / UTM zone %dN", int(zone)))
 }
 
 // ETRS89AustriaLambert represents projected Coordinate Reference System's similar to
 // https://epsg.io/3416
 func ETRS89AustriaLambert() ProjectedReferenceSystem {
-	return ETRS89().LambertConformalConic2SP(13.33333333333333, 47.5, 49, 46, 400000, 400000)
+	return ETRS89().LambertConformalConic2SP(13.33333333333333, 47.5, 49, 46, 400000, 400000).
+		withMetadata(3416, "ETRS89 / Austria Lambert")
 }
 
+// ETRS89LAEA is a projected Coordinate Reference System similar to
+// https://epsg.io/3035
+func ETRS89LAEA() ProjectedReferenceSystem {
+	return ETRS89().LambertAzimuthalEqualArea(10, 52, 4321000, 3210000).
+		withMetadata(3035, "ETRS89-extended / LAEA Europe")
+}
+
+// ETRS89LambertAzimuthalEqualArea is the same as ETRS89LAEA.
 func ETRS89LambertAzimuthalEqualArea() ProjectedReferenceSystem {
-	return ETRS89().LambertAzimuthalEqualArea(10, 52, 4321000, 3210000)
+	return ETRS89LAEA()
 }
 
 // MGIAustriaLambert represents projected Coordinate Reference System's similar to
 // https://epsg.io/31287
 func MGIAustriaLambert() ProjectedReferenceSystem {
-	return MGI().LambertConformalConic2SP(13.33333333333333, 47.5, 49, 46, 400000, 400000)
+	return MGI().LambertConformalConic2SP(13.33333333333333, 47.5, 49, 46, 400000, 400000).
+		withMetadata(31287, "MGI / Austria Lambert")
 }
 
 // MGIAustriaM28 represents projected Coordinate Reference System's similar to
 // https://epsg.io/31284
 func MGIAustriaM28() ProjectedReferenceSystem {
-	return MGI().TransverseMercator(10.33333333333333, 0, 1, 150000, 0)
+	return MGI().TransverseMercator(10.33333333333333, 0, 1, 150000, 0).
+		withMetadata(31284, "MGI / Austria M28")
 }
 
 // MGIAustriaM31 represents projected Coordinate Reference System's similar to
 // https://epsg.io/31285
 func MGIAustriaM31() ProjectedReferenceSystem {
-	return MGI().TransverseMercator(13.33333333333333, 0, 1, 450000, 0)
+	return MGI().TransverseMercator(13.33333333333333, 0, 1, 450000, 0).
+		withMetadata(31285, "MGI / Austria M31")
 }
 
 // MGIAustriaM34 represents projected Coordinate Reference System's similar to
 // https://epsg.io/31286
 func MGIAustriaM34() ProjectedReferenceSystem {
-	return MGI().TransverseMercator(16.33333333333333, 0, 1, 750000, 0)
+	return MGI().TransverseMercator(16.33333333333333, 0, 1, 750000, 0).
+		withMetadata(31286, "MGI / Austria M34")
 }
 
 // MGIAustriaGKM28 represents projected Coordinate Reference System's similar to
 // https://epsg.io/31257
 func MGIAustriaGKM28() ProjectedReferenceSystem {
-	return MGI().TransverseMercator(10.33333333333333, 0, 1, 150000, -5000000)
+	return MGI().TransverseMercator(10.33333333333333, 0, 1, 150000, -5000000).
+		withMetadata(31257, "MGI / Austria GK M28")
 }
 
 // MGIAustriaGKM31 represents projected Coordinate Reference System's similar to
 // https://epsg.io/31258
 func MGIAustriaGKM31() ProjectedReferenceSystem {
-	return MGI().TransverseMercator(13.33333333333333, 0, 1, 450000, -5000000)
+	return MGI().TransverseMercator(13.33333333333333, 0, 1, 450000, -5000000).
+		withMetadata(31258, "MGI / Austria GK M31")
 }
 
 // MGIAustriaGKM34 represents projected Coordinate Reference System's similar to
 // https://epsg.io/31259
 func MGIAustriaGKM34() ProjectedReferenceSystem {
-	return MGI().TransverseMercator(16.33333333333333, 0, 1, 750000, -5000000)
+	return MGI().TransverseMercator(16.33333333333333, 0, 1, 750000, -5000000).
+		withMetadata(31259, "MGI / Austria GK M34")
 }
 
 // OSGB36NationalGrid is a projected Coordinate Reference System similar to
 // https://epsg.io/27700
 func OSGB36NationalGrid() ProjectedReferenceSystem {
-	return OSGB36().TransverseMercator(-2, 49, 0.9996012717, 400000, -100000)
+	return OSGB36().TransverseMercator(-2, 49, 0.9996012717, 400000, -100000).
+		withMetadata(27700, "OSGB36 / British National Grid")
+}
+
+// IrishNationalGrid is a projected Coordinate Reference System similar to
+// https://epsg.io/29903
+func IrishNationalGrid() ProjectedReferenceSystem {
+	return Ireland1965().TransverseMercator(-8, 53.5, 1.000035, 200000, 250000).
+		withMetadata(29903, "TM75 / Irish Grid")
+}
+
+// NorthernIrelandING is the IrishNationalGrid restricted to Northern
+// Ireland.
+func NorthernIrelandING() ProjectedReferenceSystem {
+	crs := IrishNationalGrid()
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= -8.18 && lon <= -5.34 && lat >= 54.03 && lat <= 55.31
+	})
+
+	return crs
+}
+
+// IrishTransverseMercator is a projected Coordinate Reference System similar
+// to https://epsg.io/2157
+func IrishTransverseMercator() ProjectedReferenceSystem {
+	return IRENET95().TransverseMercator(-8, 53.5, 0.99982, 600000, 750000).
+		withMetadata(2157, "IRENET95 / Irish Transverse Mercator")
+}
+
+// RDNew is a projected Coordinate Reference System similar to
+// https://epsg.io/28992
+//
+// It's the Oblique Stereographic projection of the Amersfoort Datum without
+// the correction grid of RDNAPTRANS, so it's accurate to about 1 meter.
+func RDNew() ProjectedReferenceSystem {
+	return Amersfoort().Stereographic(5.387638888888889, 52.15616055555555, 0.9999079, 155000, 463000).
+		withMetadata(28992, "Amersfoort / RD New")
+}
+
+// SwissLV95 is a projected Coordinate Reference System similar to
+// https://epsg.io/2056
+//
+// It's the Oblique Mercator projection of the CH1903+ Datum at the old
+// observatory of Bern.
+func SwissLV95() ProjectedReferenceSystem {
+	return CH1903Plus().HotineObliqueMercator(7.439583333333333, 46.952405555555565, 90, 90, 1, 2600000, 1200000).
+		withMetadata(2056, "CH1903+ / LV95")
+}
+
+// SwissLV03 is a projected Coordinate Reference System similar to
+// https://epsg.io/21781
+func SwissLV03() ProjectedReferenceSystem {
+	return CH1903().HotineObliqueMercator(7.439583333333333, 46.952405555555565, 90, 90, 1, 600000, 200000).
+		withMetadata(21781, "CH1903 / LV03")
+}
+
+// LV95ToLV03 converts SwissLV95 to SwissLV03 coordinates by removing the
+// leading digits of the false easting and northing.
+//
+// It ignores the distortions of LV03 of up to 1.6 meters that are modelled by
+// the FINELTRA transformation of swisstopo.
+func LV95ToLV03(e, n float64) (e2, n2 float64) {
+	return e - 2000000, n - 1000000
 }
 
 // DHDN2001GK represents projected Coordinate Reference System's similar to
@@ -132,19 +243,178 @@ func DHDN2001GK(zone float64) ProjectedReferenceSystem {
 		return lon >= zone*3-1.5 && lon <= zone*3+1.5 && lat >= 0 && lat <= 84
 	})
 
-	return crs
+	return crs.withMetadata(31464+int(zone), fmt.Sprintf("DHDN / 3-degree Gauss-Kruger zone %d", int(zone)))
+}
+
+// Pulkovo1942LonLat is a geographic Coordinate Reference System similar to
+// https://epsg.io/4284
+func Pulkovo1942LonLat() GeographicReferenceSystem {
+	return Pulkovo1942().LonLat().withMetadata(4284, "Pulkovo 1942")
+}
+
+// Pulkovo1942GK represents projected Coordinate Reference System's similar to
+// https://epsg.io/28404
+//
+// The zones 4 to 32 are 6° wide, the central meridian of zone 32 is 189°.
+// The Areas are limited to the strips within the Area of Pulkovo1942.
+// Returns a ProjectedReferenceSystem without Projection for other zones.
+func Pulkovo1942GK(zone float64) ProjectedReferenceSystem {
+	if zone != math.Trunc(zone) || zone < 4 || zone > 32 {
+		return ProjectedReferenceSystem{}
+	}
+
+	lonf := zone*6 - 3
+
+	crs := Pulkovo1942().TransverseMercator(lonf, 0, 1, zone*1000000+500000, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return math.Abs(math.Remainder(lon-lonf, 360)) <= 3
+	})
+
+	return crs.withMetadata(28400+int(zone), fmt.Sprintf("Pulkovo 1942 / Gauss-Kruger zone %d", int(zone)))
+}
+
+// CGCS2000LonLat is a geographic Coordinate Reference System similar to
+// https://epsg.io/4490
+//
+// It can't be used for GCJ-02 coordinates, see CGCS2000.
+func CGCS2000LonLat() GeographicReferenceSystem {
+	return CGCS2000().LonLat().withMetadata(4490, "China Geodetic Coordinate System 2000")
+}
+
+// CGCS2000GaussKruger represents projected Coordinate Reference System's
+// similar to https://epsg.io/4491 or https://epsg.io/4513
+//
+// The zones 13 to 23 are 6° wide, the zones 25 to 45 are 3° wide. The false
+// easting is prefixed by the zone. Returns a ProjectedReferenceSystem without
+// Projection for other zones.
+func CGCS2000GaussKruger(zone float64) ProjectedReferenceSystem {
+	var lonf, width float64
+
+	switch {
+	case zone != math.Trunc(zone):
+		return ProjectedReferenceSystem{}
+	case zone >= 13 && zone <= 23:
+		lonf, width = zone*6-3, 6
+	case zone >= 25 && zone <= 45:
+		lonf, width = zone*3, 3
+	default:
+		return ProjectedReferenceSystem{}
+	}
+
+	crs := CGCS2000().TransverseMercator(lonf, 0, 1, zone*1000000+500000, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= lonf-width/2 && lon <= lonf+width/2
+	})
+
+	if width == 6 {
+		return crs.withMetadata(4478+int(zone), fmt.Sprintf("CGCS2000 / Gauss-Kruger zone %d", int(zone)))
+	}
+
+	return crs.withMetadata(4488+int(zone), fmt.Sprintf("CGCS2000 / 3-degree Gauss-Kruger zone %d", int(zone)))
+}
+
+// SWEREF99TM is a projected Coordinate Reference System similar to
+// https://epsg.io/3006
+func SWEREF99TM() ProjectedReferenceSystem {
+	return SWEREF99().TransverseMercator(15, 0, 0.9996, 500000, 0).withMetadata(3006, "SWEREF99 TM")
+}
+
+// sweref99Zones are the EPSG codes of the local projections of SWEREF99 by
+// central meridian.
+var sweref99Zones = map[float64]int{
+	12: 3007, 13.5: 3008, 15: 3009, 16.5: 3010, 18: 3011, 14.25: 3012,
+	15.75: 3013, 17.25: 3014, 18.75: 3015, 20.25: 3016, 21.75: 3017, 23.25: 3018,
+}
+
+// SWEREF99Zone represents projected Coordinate Reference System's similar to
+// https://epsg.io/3007
+//
+// lon is the central meridian of the local projection, like 12 for SWEREF99
+// 12 00 or 14.25 for SWEREF99 14 15. Returns a ProjectedReferenceSystem
+// without Projection for other meridians.
+//
+// The Area is limited to 1.5° around the meridian within SWEREF99. It
+// doesn't follow the counties Lantmäteriet assigns to the local projections,
+// so it overlaps the neighbouring zones.
+func SWEREF99Zone(lon float64) ProjectedReferenceSystem {
+	code, ok := sweref99Zones[lon]
+	if !ok {
+		return ProjectedReferenceSystem{}
+	}
+
+	crs := SWEREF99().TransverseMercator(lon, 0, 1, 150000, 0)
+	crs.Area = AreaFunc(func(lo, la float64) bool {
+		return lo >= lon-1.5 && lo <= lon+1.5 && SWEREF99().Contains(lo, la)
+	})
+
+	degrees, minutes := math.Modf(lon)
+
+	return crs.withMetadata(code, fmt.Sprintf("SWEREF99 %02d %02d", int(degrees), int(math.Round(minutes*60))))
+}
+
+// japanZones are the origins of the Japan Plane Rectangular zones I to XIX
+// and approximate bounding boxes of the prefectures and islands they cover.
+var japanZones = [...]struct {
+	numeral                              string
+	lonf, latf, west, east, south, north float64
+}{
+	{"I", 129.5, 33, 128.17, 130.46, 26.96, 34.74},
+	{"II", 131, 33, 129.76, 132.05, 30.18, 34.13},
+	{"III", 132.16666666666666, 36, 130.81, 133.49, 33.72, 36.38},
+	{"IV", 133.5, 33, 131.95, 134.81, 32.69, 34.45},
+	{"V", 134.33333333333334, 36, 133.13, 135.47, 34.13, 35.71},
+	{"VI", 136, 36, 134.86, 136.99, 33.4, 36.33},
+	{"VII", 137.16666666666666, 36, 136.22, 137.84, 34.51, 37.58},
+	{"VIII", 138.5, 36, 137.32, 139.91, 34.54, 38.58},
+	{"IX", 139.83333333333334, 36, 138.4, 141.11, 29.31, 37.98},
+	{"X", 140.83333333333334, 40, 139.49, 142.14, 37.73, 41.58},
+	{"XI", 140.25, 44, 139.34, 141.46, 41.34, 43.42},
+	{"XII", 142.25, 44, 140.89, 143.61, 42.15, 45.54},
+	{"XIII", 144.25, 44, 142.61, 145.87, 41.87, 44.4},
+	{"XIV", 142, 26, 140.46, 143.69, 24.67, 27.8},
+	{"XV", 127.5, 26, 126.63, 128.4, 25.92, 27.91},
+	{"XVI", 124, 26, 122.83, 125.97, 23.98, 24.94},
+	{"XVII", 131, 26, 131.12, 131.38, 24.4, 26.01},
+	{"XVIII", 136, 20, 136.02, 136.16, 20.37, 20.48},
+	{"XIX", 154, 26, 153.91, 154.05, 24.22, 24.35},
+}
+
+// JapanPlaneRectangular represents projected Coordinate Reference System's
+// similar to https://epsg.io/6669
+//
+// The zones 1 to 19 are Transverse Mercator projections of JGD2011 with the
+// origins of the Geospatial Information Authority of Japan, like zone 1 for
+// Nagasaki or zone 9 for Tokyo. The Areas are bounding boxes of the
+// prefectures of each zone, so neighbouring zones overlap.
+//
+// Returns a ProjectedReferenceSystem without Projection for other zones.
+func JapanPlaneRectangular(zone int) ProjectedReferenceSystem {
+	if zone < 1 || zone > len(japanZones) {
+		return ProjectedReferenceSystem{}
+	}
+
+	z := japanZones[zone-1]
+
+	crs := JGD2011().TransverseMercator(z.lonf, z.latf, 0.9999, 0, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= z.west && lon <= z.east && lat >= z.south && lat <= z.north
+	})
+
+	return crs.withMetadata(6668+zone, "JGD2011 / Japan Plane Rectangular CS "+z.numeral)
 }
 
 // RGF93CC represents projected Coordinate Reference System's similar to
 // https://epsg.io/3950
 func RGF93CC(lat float64) ProjectedReferenceSystem {
-	return RGF93().LambertConformalConic2SP(3, lat, lat-0.75, lat+0.75, 1700000, 2200000+(lat-43)*1000000)
+	return RGF93().LambertConformalConic2SP(3, lat, lat-0.75, lat+0.75, 1700000, 2200000+(lat-43)*1000000).
+		withMetadata(3900+int(lat), fmt.Sprintf("RGF93 / CC%d", int(lat)))
 }
 
 // RGF93FranceLambert is a projected Coordinate Reference System similar to
 // https://epsg.io/2154
 func RGF93FranceLambert() ProjectedReferenceSystem {
-	return RGF93().LambertConformalConic2SP(3, 46.5, 49, 44, 700000, 6600000)
+	return RGF93().LambertConformalConic2SP(3, 46.5, 49, 44, 700000, 6600000).
+		withMetadata(2154, "RGF93 / Lambert-93")
 }
 
 // NAD83AlabamaEast is a projected Coordinate Reference System similar to
@@ -155,7 +425,7 @@ func NAD83AlabamaEast() ProjectedReferenceSystem {
 		return lon >= -86.79 && lon <= -84.89 && lat >= 30.99 && lat <= 35.0
 	})
 
-	return crs
+	return crs.withMetadata(6355, "NAD83(2011) / Alabama East")
 }
 
 // NAD83AlabamaWest is a projected Coordinate Reference System similar to
@@ -166,23 +436,465 @@ func NAD83AlabamaWest() ProjectedReferenceSystem {
 		return lon >= -88.48 && lon <= -86.3 && lat >= 30.14 && lat <= 35.02
 	})
 
-	return crs
+	return crs.withMetadata(6356, "NAD83(2011) / Alabama West")
 }
 
 // NAD83CaliforniaAlbers is a projected Coordinate Reference System similar to
 // https://epsg.io/6414
 func NAD83CaliforniaAlbers() ProjectedReferenceSystem {
-	crs := NAD83().AlbersEqualAreaConic(34, 40.5, 0, -120, 0, -4000000)
+	crs := NAD83().AlbersEqualAreaConic(-120, 0, 34, 40.5, 0, -4000000)
 	crs.Area = AreaFunc(func(lon, lat float64) bool {
 		return lon >= -124.45 && lon <= -114.12 && lat >= 32.53 && lat <= 42.01
 	})
 
+	return crs.withMetadata(6414, "NAD83(2011) / California Albers")
+}
+
+// BCAlbers is a projected Coordinate Reference System similar to
+// https://epsg.io/3005
+func BCAlbers() ProjectedReferenceSystem {
+	crs := NAD83().AlbersEqualAreaConic(-126, 45, 50, 58.5, 1000000, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= -139.04 && lon <= -114.08 && lat >= 48.25 && lat <= 60.01
+	})
+
+	return crs.withMetadata(3005, "NAD83 / BC Albers")
+}
+
+// CanadaAlbers is a projected Coordinate Reference System similar to
+// https://epsg.io/102001
+//
+// It has no EPSG code, the code is ESRI:102001.
+func CanadaAlbers() ProjectedReferenceSystem {
+	crs := NAD83().AlbersEqualAreaConic(-96, 40, 50, 70, 0, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= -141.01 && lon <= -47.74 && lat >= 40.04 && lat <= 86.46
+	})
+
+	return crs.withMetadata(0, "NAD83 / Canada Albers Equal Area Conic")
+}
+
+// AustraliaAlbers is a projected Coordinate Reference System similar to
+// https://epsg.io/9473
+func AustraliaAlbers() ProjectedReferenceSystem {
+	crs := GDA2020().AlbersEqualAreaConic(132, 0, -18, -36, 0, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= 112.85 && lon <= 153.69 && lat >= -43.7 && lat <= -9.86
+	})
+
+	return crs.withMetadata(9473, "GDA2020 / Australian Albers")
+}
+
+// AFREFUTM represents the UTM zones on the AFREF Datum.
+//
+// The Area is the part of the UTM zone within the bounding box of Africa.
+// The zones have no EPSG-Codes. National Transverse Mercator systems of the
+// countries that adopted AFREF are not provided, because AFREF doesn't
+// publish their projection parameters and extents. They can be built with
+// AFREF().TransverseMercator.
+func AFREFUTM(zone float64, northern bool) ProjectedReferenceSystem {
+	northf := 0.0
+	if !northern {
+		northf = 10000000
+	}
+
+	crs := AFREF().TransverseMercator(zone*6-183, 0, 0.9996, 500000, northf)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		if lon < zone*6-186 || lon > zone*6-180 || !AFREF().Contains(lon, lat) {
+			return false
+		}
+
+		return northern == (lat >= 0)
+	})
+
+	if northern {
+		return crs.withMetadata(0, fmt.Sprintf("AFREF / UTM zone %dN", int(zone)))
+	}
+
+	return crs.withMetadata(0, fmt.Sprintf("AFREF / UTM zone %dS", int(zone)))
+}
+
+// ED50UTM represents projected Coordinate Reference System's similar to
+// https://epsg.io/23030
+//
+// The zones are limited to the Area of ED50, so the zones 28 to 38 cover
+// Europe.
+func ED50UTM(zone float64) ProjectedReferenceSystem {
+	crs := ED50().TransverseMercator(zone*6-183, 0, 0.9996, 500000, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= zone*6-186 && lon <= zone*6-180
+	})
+
+	return crs.withMetadata(23000+int(zone), fmt.Sprintf("ED50 / UTM zone %dN", int(zone)))
+}
+
+// ED50SpainUTM30 is a projected Coordinate Reference System similar to
+// https://epsg.io/23030
+//
+// It's ED50UTM(30) with the Area of mainland Spain and the Balearic Islands,
+// where zone 30 is also used outside of its strip. LoadPENR2009 provides the
+// grid of IGN for ED50 in Spain.
+func ED50SpainUTM30() ProjectedReferenceSystem {
+	crs := ED50UTM(30)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= -9.37 && lon <= 4.39 && lat >= 35.95 && lat <= 43.82
+	})
+
+	return crs
+}
+
+// NAD27UTM represents projected Coordinate Reference System's similar to
+// https://epsg.io/26715
+//
+// The zones are limited to the Area of NAD27, so the zones 10 to 19 cover the
+// conterminous United States. NAD27 has no southern zones and EPSG defines
+// only the zones 1 to 22, so a ProjectedReferenceSystem without Projection is
+// returned for southern and other zones.
+func NAD27UTM(zone float64, northern bool) ProjectedReferenceSystem {
+	if !northern || zone != math.Trunc(zone) || zone < 1 || zone > 22 {
+		return ProjectedReferenceSystem{}
+	}
+
+	crs := NAD27().TransverseMercator(zone*6-183, 0, 0.9996, 500000, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= zone*6-186 && lon <= zone*6-180
+	})
+
+	return crs.withMetadata(26700+int(zone), fmt.Sprintf("NAD27 / UTM zone %dN", int(zone)))
+}
+
+// MexicoUTM represents projected Coordinate Reference System's similar to
+// https://epsg.io/6366
+//
+// The zones 11 to 16 cover Mexico.
+func MexicoUTM(zone float64) ProjectedReferenceSystem {
+	crs := MexicoITRF2008().TransverseMercator(zone*6-183, 0, 0.9996, 500000, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= zone*6-186 && lon <= zone*6-180 && MexicoITRF2008().Contains(lon, lat)
+	})
+
+	return crs.withMetadata(6355+int(zone), fmt.Sprintf("Mexico ITRF2008 / UTM zone %dN", int(zone)))
+}
+
+// POSGAR07FajaX represents projected Coordinate Reference System's similar
+// to https://epsg.io/5343
+//
+// Returns ErrInvalidZone for zones outside of 1 to 7.
+func POSGAR07FajaX(zone int) (ProjectedReferenceSystem, error) {
+	if zone < 1 || zone > 7 {
+		return ProjectedReferenceSystem{}, ErrInvalidZone
+	}
+
+	lonf := float64(zone*3 - 75)
+
+	crs := POSGAR07().TransverseMercator(lonf, -90, 1, float64(zone)*1000000+500000, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= lonf-1.5 && lon <= lonf+1.5 && POSGAR07().Contains(lon, lat)
+	})
+
+	return crs.withMetadata(5342+zone, fmt.Sprintf("POSGAR 2007 / Argentina %d", zone)), nil
+}
+
+// PSAD56UTM represents projected Coordinate Reference System's similar to
+// https://epsg.io/24818 or https://epsg.io/24878
+//
+// The zones 17 to 22 cover South America.
+func PSAD56UTM(zone float64, northern bool) ProjectedReferenceSystem {
+	northf := 0.0
+	if !northern {
+		northf = 10000000
+	}
+
+	crs := PSAD56().TransverseMercator(zone*6-183, 0, 0.9996, 500000, northf)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		if lon < zone*6-186 || lon > zone*6-180 || !PSAD56().Contains(lon, lat) {
+			return false
+		}
+
+		return northern == (lat >= 0)
+	})
+
+	if northern {
+		return crs.withMetadata(24800+int(zone), fmt.Sprintf("PSAD56 / UTM zone %dN", int(zone)))
+	}
+
+	return crs.withMetadata(24860+int(zone), fmt.Sprintf("PSAD56 / UTM zone %dS", int(zone)))
+}
+
+// ColombiaFarWest is a projected Coordinate Reference System similar to
+// https://epsg.io/3114
+func ColombiaFarWest() ProjectedReferenceSystem {
+	return colombiaZone(-80.07750791666666).withMetadata(3114, "MAGNA-SIRGAS / Colombia Far West zone")
+}
+
+// ColombiaWest is a projected Coordinate Reference System similar to
+// https://epsg.io/3115
+func ColombiaWest() ProjectedReferenceSystem {
+	return colombiaZone(-77.07750791666666).withMetadata(3115, "MAGNA-SIRGAS / Colombia West zone")
+}
+
+// ColombiaBogota is a projected Coordinate Reference System similar to
+// https://epsg.io/3116
+func ColombiaBogota() ProjectedReferenceSystem {
+	return colombiaZone(-74.07750791666666).withMetadata(3116, "MAGNA-SIRGAS / Colombia Bogota zone")
+}
+
+// ColombiaEastCentral is a projected Coordinate Reference System similar to
+// https://epsg.io/3117
+func ColombiaEastCentral() ProjectedReferenceSystem {
+	return colombiaZone(-71.07750791666666).withMetadata(3117, "MAGNA-SIRGAS / Colombia East Central zone")
+}
+
+// ColombiaEast is a projected Coordinate Reference System similar to
+// https://epsg.io/3118
+func ColombiaEast() ProjectedReferenceSystem {
+	return colombiaZone(-68.07750791666666).withMetadata(3118, "MAGNA-SIRGAS / Colombia East zone")
+}
+
+func colombiaZone(lonf float64) ProjectedReferenceSystem {
+	crs := MAGNA().TransverseMercator(lonf, 4.596200416666666, 1, 1000000, 1000000)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= lonf-1.5 && lon <= lonf+1.5 && MAGNA().Contains(lon, lat)
+	})
+
 	return crs
 }
 
+// ChileUTM represents the projected Coordinate Reference System's of Chile
+// using the southern UTM zones 18 and 19 on the SSITDEF Datum.
+//
+// The Area is the part of the UTM zone within mainland Chile and the Chilean
+// Antarctic Territory. The zones have no EPSG-Codes. Returns a
+// ProjectedReferenceSystem without Projection for other zones.
+func ChileUTM(zone float64) ProjectedReferenceSystem {
+	if zone != 18 && zone != 19 {
+		return ProjectedReferenceSystem{}
+	}
+
+	crs := SSITDEF().TransverseMercator(zone*6-183, 0, 0.9996, 500000, 10000000)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= zone*6-186 && lon <= zone*6-180 && SSITDEF().Contains(lon, lat)
+	})
+
+	return crs.withMetadata(0, fmt.Sprintf("SSITDEF / UTM zone %dS", int(zone)))
+}
+
+// REGVENUTMZone19N is a projected Coordinate Reference System similar to
+// https://epsg.io/2202
+func REGVENUTMZone19N() ProjectedReferenceSystem {
+	return regvenUTM(19).withMetadata(2202, "REGVEN / UTM zone 19N")
+}
+
+// REGVENUTMZone20N is a projected Coordinate Reference System similar to
+// https://epsg.io/2203
+func REGVENUTMZone20N() ProjectedReferenceSystem {
+	return regvenUTM(20).withMetadata(2203, "REGVEN / UTM zone 20N")
+}
+
+func regvenUTM(zone float64) ProjectedReferenceSystem {
+	crs := REGVEN().TransverseMercator(zone*6-183, 0, 0.9996, 500000, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= zone*6-186 && lon <= zone*6-180 && REGVEN().Contains(lon, lat)
+	})
+
+	return crs
+}
+
+// MalaysiaRSO is a projected Coordinate Reference System similar to
+// https://epsg.io/3375
+func MalaysiaRSO() ProjectedReferenceSystem {
+	crs := GDM2000().HotineObliqueMercatorA(102.25, 4, 323.0257964666666, 323.1301023611111, 0.99984, 804671, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= 99.59 && lon <= 104.6 && lat >= 1.13 && lat <= 6.72
+	})
+
+	return crs.withMetadata(3375, "GDM2000 / Peninsula RSO")
+}
+
+// SJTSK is a projected Coordinate Reference System similar to
+// https://epsg.io/5513
+//
+// The coordinates are the positive westing and southing of the Krovak
+// projection, in this order.
+func SJTSK() ProjectedReferenceSystem {
+	crs := SJTSKEastNorth()
+	p, _ := crs.Projection.(proj.Krovak)
+	p.SouthWest = true
+	crs.Projection = p
+
+	return crs.withMetadata(5513, "S-JTSK / Krovak")
+}
+
+// SJTSKEastNorth is a projected Coordinate Reference System similar to
+// https://epsg.io/5514
+func SJTSKEastNorth() ProjectedReferenceSystem {
+	return Datum(datum.SJTSK()).
+		Krovak(24.833333333333332, 49.5, 30.288139752777777, 78.5, 0.9999, 0, 0).
+		withMetadata(5514, "S-JTSK / Krovak East North")
+}
+
+// CzechRepublicKrovak is SJTSKEastNorth restricted to the Czech Republic.
+func CzechRepublicKrovak() ProjectedReferenceSystem {
+	crs := SJTSKEastNorth()
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= 12.09 && lon <= 18.86 && lat >= 48.58 && lat <= 51.06
+	})
+
+	return crs
+}
+
+// TrinidadGrid is a projected Coordinate Reference System similar to
+// https://epsg.io/30200
+//
+// The coordinates are in meters instead of Clarke's links of 0.201166195164
+// meters.
+func TrinidadGrid() ProjectedReferenceSystem {
+	crs := Trinidad1903().CassiniSoldner(-61.333333333333336, 10.441666666666666, 86501.46392052, 65379.0134283)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= -62.09 && lon <= -60.86 && lat >= 9.83 && lat <= 10.89
+	})
+
+	return crs.withMetadata(30200, "Trinidad 1903 / Trinidad Grid")
+}
+
+// BelgiumLambert72 is a projected Coordinate Reference System similar to
+// https://epsg.io/31370
+//
+// EPSG:31370 is defined with two standard parallels.
+func BelgiumLambert72() ProjectedReferenceSystem {
+	return Belge1972().LambertConformalConic2SP(4.367486666666666, 90, 51.166667233333335, 49.8333339, 150000.013, 5400088.438).
+		withMetadata(31370, "BD72 / Belgian Lambert 72")
+}
+
+// BrazilPolyconic is a projected Coordinate Reference System similar to
+// https://epsg.io/29101
+func BrazilPolyconic() ProjectedReferenceSystem {
+	crs := SAD69().Polyconic(-54, 0, 5000000, 10000000)
+	crs.Area = AreaFunc(brazil)
+
+	return crs.withMetadata(29101, "SAD69 / Brazil Polyconic")
+}
+
+// SIRGAS2000LonLat is a geographic Coordinate Reference System similar to
+// https://epsg.io/4674
+func SIRGAS2000LonLat() GeographicReferenceSystem {
+	return SIRGAS2000().LonLat().withMetadata(4674, "SIRGAS 2000")
+}
+
+// SIRGAS2000BrazilPolyconic is a projected Coordinate Reference System similar
+// to https://epsg.io/5880
+func SIRGAS2000BrazilPolyconic() ProjectedReferenceSystem {
+	crs := SIRGAS2000().Polyconic(-54, 0, 5000000, 10000000)
+	crs.Area = AreaFunc(brazil)
+
+	return crs.withMetadata(5880, "SIRGAS 2000 / Brazil Polyconic")
+}
+
+// BrazilAlbers is a projected Coordinate Reference System similar to the
+// Albers Equal Area projection of IBGE for Brazil.
+//
+// It has no EPSG-Code.
+func BrazilAlbers() ProjectedReferenceSystem {
+	crs := SIRGAS2000().AlbersEqualAreaConic(-54, -12, -2, -22, 5000000, 10000000)
+	crs.Area = AreaFunc(brazil)
+
+	return crs.withMetadata(0, "SIRGAS 2000 / Brazil Albers")
+}
+
+// BrazilUTM represents projected Coordinate Reference System's similar to
+// https://epsg.io/31983
+//
+// The Areas are limited to Brazil, so the zones 18 to 25 south and 18 to 24
+// north are valid. Returns a ProjectedReferenceSystem without Projection for
+// other zones.
+func BrazilUTM(zone float64, southern bool) ProjectedReferenceSystem {
+	if zone < 18 || zone > 25 || zone != math.Trunc(zone) || !southern && zone == 25 {
+		return ProjectedReferenceSystem{}
+	}
+
+	northf := 0.0
+	if southern {
+		northf = 10000000
+	}
+
+	crs := SIRGAS2000().TransverseMercator(zone*6-183, 0, 0.9996, 500000, northf)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= zone*6-186 && lon <= zone*6-180 && (southern == (lat <= 0)) && brazil(lon, lat)
+	})
+
+	if southern {
+		return crs.withMetadata(31960+int(zone), fmt.Sprintf("SIRGAS 2000 / UTM zone %dS", int(zone)))
+	}
+
+	code := 31954 + int(zone)
+	if zone > 22 {
+		code = 6187 + int(zone)
+	}
+
+	return crs.withMetadata(code, fmt.Sprintf("SIRGAS 2000 / UTM zone %dN", int(zone)))
+}
+
+// brazil is the bounding box of Brazil including its islands.
+func brazil(lon, lat float64) bool {
+	return lon >= -74.01 && lon <= -25.28 && lat >= -35.71 && lat <= 7.04
+}
+
+// NZTM2000 is a projected Coordinate Reference System similar to
+// https://epsg.io/2193
+func NZTM2000() ProjectedReferenceSystem {
+	return NZGD2000().TransverseMercator(173, 0, 0.9996, 1600000, 10000000).withMetadata(2193, "NZGD2000 / New Zealand Transverse Mercator 2000")
+}
+
+// NZMG is a projected Coordinate Reference System similar to
+// https://epsg.io/27200
+func NZMG() ProjectedReferenceSystem {
+	return NZGD49().NewZealandMapGrid(173, -41, 2510000, 6023150).withMetadata(27200, "NZGD49 / New Zealand Map Grid")
+}
+
+// GuamMapGrid is a projected Coordinate Reference System similar to
+// https://epsg.io/3993
+//
+// It uses the Guam Projection, so its Area is limited to Guam.
+func GuamMapGrid() ProjectedReferenceSystem {
+	crs := Guam1963().GuamProjection(144.74875070555555, 13.472466352777777, 50000, 50000)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lon >= 144.58 && lon <= 145.01 && lat >= 13.18 && lat <= 13.7
+	})
+
+	return crs.withMetadata(3993, "Guam 1963 / Guam SPCS")
+}
+
 // GeocentricReferenceSystem represents a geocentric Coordinate Reference System.
+//
+// It must be built with keyed fields, see CRSMetadata.
 type GeocentricReferenceSystem struct {
 	Datum Datum
+	code  int
+	name  string
+}
+
+// AntarcticPolarStereographic is a projected Coordinate Reference System
+// similar to https://epsg.io/3031
+func AntarcticPolarStereographic() ProjectedReferenceSystem {
+	d := WGS84()
+	crs := d.PolarStereographic(0, -90, proj.PolarStereographicScale(-71, d), 0, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lat <= -60
+	})
+
+	return crs.withMetadata(3031, "WGS 84 / Antarctic Polar Stereographic")
+}
+
+// ArcticPolarStereographic is a projected Coordinate Reference System
+// similar to https://epsg.io/3413
+func ArcticPolarStereographic() ProjectedReferenceSystem {
+	d := WGS84()
+	crs := d.PolarStereographic(-45, 90, proj.PolarStereographicScale(70, d), 0, 0)
+	crs.Area = AreaFunc(func(lon, lat float64) bool {
+		return lat >= 30
+	})
+
+	return crs.withMetadata(3413, "WGS 84 / NSIDC Sea Ice Polar Stereographic North")
 }
 
 // Contains method is the implementation of the Area interface.
@@ -222,9 +934,37 @@ func (crs GeocentricReferenceSystem) SafeFrom(from CoordinateReferenceSystem) Sa
 	return SafeTransform(from, crs)
 }
 
+// EPSGCode method is one method of the CRSMetadata interface.
+//
+// Returns 0 if the EPSG-Code is unknown.
+func (crs GeocentricReferenceSystem) EPSGCode() int {
+	return crs.code
+}
+
+// Name method is one method of the CRSMetadata interface.
+func (crs GeocentricReferenceSystem) Name() string {
+	return crs.name
+}
+
+// AreaOfUse method is one method of the CRSMetadata interface.
+func (crs GeocentricReferenceSystem) AreaOfUse() Area {
+	return AreaFunc(crs.Contains)
+}
+
+func (crs GeocentricReferenceSystem) withMetadata(code int, name string) GeocentricReferenceSystem {
+	crs.code = code
+	crs.name = name
+
+	return crs
+}
+
 // GeographicReferenceSystem represents a geographic Coordinate Reference System.
+//
+// It must be built with keyed fields, see CRSMetadata.
 type GeographicReferenceSystem struct {
 	Datum Datum
+	code  int
+	name  string
 }
 
 // Contains method is the implementation of the Area interface.
@@ -268,43 +1008,90 @@ func (crs GeographicReferenceSystem) SafeFrom(from CoordinateReferenceSystem) Sa
 	return SafeTransform(from, crs)
 }
 
+// EPSGCode method is one method of the CRSMetadata interface.
+//
+// Returns 0 if the EPSG-Code is unknown.
+func (crs GeographicReferenceSystem) EPSGCode() int {
+	return crs.code
+}
+
+// Name method is one method of the CRSMetadata interface.
+func (crs GeographicReferenceSystem) Name() string {
+	return crs.name
+}
+
+// AreaOfUse method is one method of the CRSMetadata interface.
+func (crs GeographicReferenceSystem) AreaOfUse() Area {
+	return AreaFunc(crs.Contains)
+}
+
+func (crs GeographicReferenceSystem) withMetadata(code int, name string) GeographicReferenceSystem {
+	crs.code = code
+	crs.name = name
+
+	return crs
+}
+
 // ProjectedReferenceSystem represents a projected Coordinate Reference System.
+//
+// It must be built with keyed fields, see CRSMetadata.
 type ProjectedReferenceSystem struct {
 	Datum      Datum
 	Projection Projection
 	Area       Area
+	code       int
+	name       string
 }
 
 // Contains method is the implementation of the Area interface.
+//
+// Returns false if the Projection is nil.
 func (crs ProjectedReferenceSystem) Contains(lon, lat float64) bool {
-	return crs.Datum.Contains(lon, lat) && (crs.Area == nil || crs.Area.Contains(lon, lat))
+	return crs.Projection != nil && crs.Datum.Contains(lon, lat) && (crs.Area == nil || crs.Area.Contains(lon, lat))
 }
 
 // ToWGS84 method is one method of the CoordinateReferenceSystem interface.
+//
+// Returns NaN if the Projection is nil.
 func (crs ProjectedReferenceSystem) ToWGS84(east, north, h float64) (x0, y0, z0 float64) {
 	if crs.Projection == nil {
-		return crs.Datum.WebMercator().ToWGS84(east, north, h)
+		return math.NaN(), math.NaN(), math.NaN()
 	}
 
-	lon, lat := crs.Projection.ToLonLat(east, north, crs.Datum)
+	lon, lat := crs.Projection.ToLonLat(east, north, spheroidOf(crs.Datum))
 	x, y, z := lonLatToXYZ(lon, lat, h, crs.Datum.A(), crs.Datum.Fi())
 
 	return crs.Datum.Forward(x, y, z)
 }
 
 // FromWGS84 method is one method of the CoordinateReferenceSystem interface.
+//
+// Returns NaN if the Projection is nil.
 func (crs ProjectedReferenceSystem) FromWGS84(x0, y0, z0 float64) (east, north, h float64) {
 	if crs.Projection == nil {
-		return crs.Datum.WebMercator().FromWGS84(x0, y0, z0)
+		return math.NaN(), math.NaN(), math.NaN()
 	}
 
 	x, y, z := crs.Datum.Inverse(x0, y0, z0)
 	lon, lat, h := xyzToLonLat(x, y, z, crs.Datum.A(), crs.Datum.Fi())
-	east, north = crs.Projection.FromLonLat(lon, lat, crs.Datum)
+	east, north = crs.Projection.FromLonLat(lon, lat, spheroidOf(crs.Datum))
 
 	return east, north, h
 }
 
+// wgs84Spheroid is the default Spheroid of a Datum.
+var wgs84Spheroid Spheroid = spheroid{a: A, fi: Fi}
+
+// spheroidOf returns the Spheroid of a Datum for a Projection. Unlike the
+// Datum itself, it doesn't allocate when it's passed as an interface.
+func spheroidOf(d Datum) Spheroid {
+	if d.Spheroid == nil {
+		return wgs84Spheroid
+	}
+
+	return d.Spheroid
+}
+
 // To provides the transformation to another CoordinateReferenceSystem.
 func (crs ProjectedReferenceSystem) To(to CoordinateReferenceSystem) Func {
 	return Transform(crs, to)
@@ -327,6 +1114,30 @@ func (crs ProjectedReferenceSystem) SafeFrom(from CoordinateReferenceSystem) Saf
 	return SafeTransform(from, crs)
 }
 
+// EPSGCode method is one method of the CRSMetadata interface.
+//
+// Returns 0 if the EPSG-Code is unknown.
+func (crs ProjectedReferenceSystem) EPSGCode() int {
+	return crs.code
+}
+
+// Name method is one method of the CRSMetadata interface.
+func (crs ProjectedReferenceSystem) Name() string {
+	return crs.name
+}
+
+// AreaOfUse method is one method of the CRSMetadata interface.
+func (crs ProjectedReferenceSystem) AreaOfUse() Area {
+	return AreaFunc(crs.Contains)
+}
+
+func (crs ProjectedReferenceSystem) withMetadata(code int, name string) ProjectedReferenceSystem {
+	crs.code = code
+	crs.name = name
+
+	return crs
+}
+
 // Transform provides a transformation between CoordinateReferenceSystems.
 func Transform(from, to CoordinateReferenceSystem) Func {
 	return func(a, b, c float64) (a2, b2, c2 float64) {
@@ -342,21 +1153,70 @@ func Transform(from, to CoordinateReferenceSystem) Func {
 	}
 }
 
+// NaNSafeTransform provides a transformation between CoordinateReferenceSystems
+// for datasets with missing data.
+//
+// If any input coordinate is NaN or a common no-data sentinel (-9999, -1e30),
+// NaN is returned for all coordinates instead of transforming the sentinel.
+func NaNSafeTransform(from, to CoordinateReferenceSystem) Func {
+	transform := Transform(from, to)
+
+	return func(a, b, c float64) (a2, b2, c2 float64) {
+		if isNoData(a) || isNoData(b) || isNoData(c) {
+			return math.NaN(), math.NaN(), math.NaN()
+		}
+
+		return transform(a, b, c)
+	}
+}
+
+func isNoData(v float64) bool {
+	return math.IsNaN(v) || v == -9999 || v == -1e30
+}
+
 var (
-	// ErrNoCoordinateReferenceSystem is a nil CoordinateReferenceSystem warning.
+	// ErrNoCoordinateReferenceSystem is a nil CoordinateReferenceSystem or
+	// ProjectedReferenceSystem without Projection warning.
 	ErrNoCoordinateReferenceSystem = errors.New("crs not specified")
 	// ErrOutOfBounds is a transformation out of the Area interface boundings.
 	ErrOutOfBounds = errors.New("coordinate is out of bounds")
+	// ErrInvalidZone is a zone outside of the range of a zoned Coordinate
+	// Reference System.
+	ErrInvalidZone = errors.New("invalid zone")
+	// ErrDifferentBodies is a transformation between Coordinate Reference
+	// Systems of different celestial bodies, like Mars and the earth.
+	ErrDifferentBodies = errors.New("crs of different bodies")
 )
 
 // SafeTransform provides a transformation between CoordinateReferenceSystems
 // with errors.
+//
+// Like in Transform, a nil from means WGS84 geocentric coordinates that
+// cover the whole world. Transformations between Coordinate Reference Systems
+// of different bodies return ErrDifferentBodies. The error of the loader of a
+// LazyDatum is returned on every call.
 func SafeTransform(from, to CoordinateReferenceSystem) SafeFunc {
+	if from == nil {
+		from = WGS84XYZ()
+	}
+
 	return func(a, b, c float64) (float64, float64, float64, error) {
-		if from == nil || to == nil {
+		if isNil(from) || isNil(to) {
 			return 0, 0, 0, ErrNoCoordinateReferenceSystem
 		}
 
+		if body(from) != body(to) {
+			return 0, 0, 0, ErrDifferentBodies
+		}
+
+		if err := loadErr(from); err != nil {
+			return 0, 0, 0, err
+		}
+
+		if err := loadErr(to); err != nil {
+			return 0, 0, 0, err
+		}
+
 		a, b, c = from.ToWGS84(a, b, c)
 
 		lon, lat, _ := xyzToLonLat(a, b, c, A, Fi)
@@ -369,3 +1229,117 @@ func SafeTransform(from, to CoordinateReferenceSystem) SafeFunc {
 		return a, b, c, nil
 	}
 }
+
+// body returns the celestial body of the Datum of a CoordinateReferenceSystem.
+// It's empty for the earth and for unknown implementations.
+func body(crs CoordinateReferenceSystem) string {
+	return datumOf(crs).Body
+}
+
+// datumOf returns the Datum of a CoordinateReferenceSystem. It's empty for
+// unknown implementations.
+func datumOf(crs CoordinateReferenceSystem) Datum {
+	switch crs := crs.(type) {
+	case GeocentricReferenceSystem:
+		return crs.Datum
+	case GeographicReferenceSystem:
+		return crs.Datum
+	case Geographic3DCRS:
+		return crs.Datum
+	case Geographic2DCRS:
+		return crs.Datum
+	case ProjectedReferenceSystem:
+		return crs.Datum
+	default:
+		return Datum{}
+	}
+}
+
+func isNil(crs CoordinateReferenceSystem) bool {
+	if crs == nil {
+		return true
+	}
+
+	p, ok := crs.(ProjectedReferenceSystem)
+
+	return ok && p.Projection == nil
+}
+
+// TransformSlice transforms a slice of coordinates between
+// CoordinateReferenceSystems.
+//
+// The result is written to dst if it has enough capacity, otherwise a new
+// slice is allocated. dst may be nil or coords itself for an in-place
+// transformation.
+func TransformSlice(from, to CoordinateReferenceSystem, coords, dst [][3]float64) [][3]float64 {
+	dst = sliceBuffer(dst, len(coords))
+	transform := Transform(from, to)
+
+	for i, c := range coords {
+		dst[i][0], dst[i][1], dst[i][2] = transform(c[0], c[1], c[2])
+	}
+
+	return dst
+}
+
+// TransformTyped transforms slices of any point type between
+// CoordinateReferenceSystems.
+//
+// The returned function reads the coordinates of each point with getCoords,
+// writes the transformed coordinates in place with setCoords and returns the
+// same slice.
+func TransformTyped[P any](from, to CoordinateReferenceSystem,
+	getCoords func(P) (float64, float64, float64), setCoords func(*P, float64, float64, float64),
+) func([]P) []P {
+	transform := Transform(from, to)
+
+	return func(points []P) []P {
+		for i := range points {
+			a, b, c := transform(getCoords(points[i]))
+			setCoords(&points[i], a, b, c)
+		}
+
+		return points
+	}
+}
+
+// TransformMany lazily transforms the points of an iterator between
+// CoordinateReferenceSystems.
+//
+// The points are transformed while the returned iterator is ranged over, or
+// called with a yield function before Go 1.23, and it stops iter when yield
+// returns false. It doesn't allocate per point.
+func TransformMany(from, to CoordinateReferenceSystem, iter func(yield func(x, y, z float64) bool)) func(yield func(x, y, z float64) bool) {
+	transform := Transform(from, to)
+
+	return func(yield func(x, y, z float64) bool) {
+		iter(func(x, y, z float64) bool {
+			return yield(transform(x, y, z))
+		})
+	}
+}
+
+// SafeTransformSlice transforms a slice of coordinates between
+// CoordinateReferenceSystems with errors.
+//
+// The errors are independent for each coordinate and nil for successful
+// transformations. dst is used like in TransformSlice.
+func SafeTransformSlice(from, to CoordinateReferenceSystem, coords, dst [][3]float64) ([][3]float64, []error) {
+	dst = sliceBuffer(dst, len(coords))
+	errs := make([]error, len(coords))
+	transform := SafeTransform(from, to)
+
+	for i, c := range coords {
+		dst[i][0], dst[i][1], dst[i][2], errs[i] = transform(c[0], c[1], c[2])
+	}
+
+	return dst, errs
+}
+
+func sliceBuffer(dst [][3]float64, n int) [][3]float64 {
+	if cap(dst) < n {
+		return make([][3]float64, n)
+	}
+
+	return dst[:n]
+}
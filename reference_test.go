@@ -0,0 +1,346 @@
+package wgs84_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+	"github.com/wroge/wgs84/proj"
+)
+
+func TestSafeTransformNilFrom(t *testing.T) {
+	t.Parallel()
+
+	lon, lat, h, err := wgs84.SafeTransform(nil, wgs84.WGS84LonLat())(wgs84.A, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lon != 0 || lat != 0 || h > 1e-6 || h < -1e-6 {
+		t.Fatal(lon, lat, h)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Fatal(r)
+			}
+		}()
+
+		_, _, _, _ = wgs84.SafeTransform(nil, wgs84.WGS84LonLat())(0, 0, 0)
+	}()
+
+	_, _, _, err = wgs84.SafeTransform(nil, nil)(wgs84.A, 0, 0)
+	if !errors.Is(err, wgs84.ErrNoCoordinateReferenceSystem) {
+		t.Fatal(err)
+	}
+
+	_, _, _, err = wgs84.EPSG().SafeTransform(1, 4326)(wgs84.A, 0, 0)
+	if !errors.Is(err, wgs84.ErrNoCoordinateReferenceSystem) {
+		t.Fatal(err)
+	}
+}
+
+// TestTransformMany isn't parallel because of testing.AllocsPerRun.
+func TestTransformMany(t *testing.T) {
+	pulled := 0
+	points := func(yield func(x, y, z float64) bool) {
+		for i := 0; i < 100; i++ {
+			pulled++
+
+			if !yield(9+float64(i)/100, 52, 0) {
+				return
+			}
+		}
+	}
+
+	transform := wgs84.Transform(wgs84.WGS84LonLat(), wgs84.UTM(32, true))
+	many := wgs84.TransformMany(wgs84.WGS84LonLat(), wgs84.UTM(32, true), points)
+
+	if pulled != 0 {
+		t.Fatal(pulled)
+	}
+
+	n := 0
+
+	many(func(east, north, _ float64) bool {
+		e, n2, _ := transform(9+float64(n)/100, 52, 0)
+		if east != e || north != n2 {
+			t.Fatal(n, east, north)
+		}
+
+		n++
+
+		return n < 10
+	})
+
+	if n != 10 || pulled != 10 {
+		t.Fatal(n, pulled)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		many(func(_, _, _ float64) bool { return true })
+	})
+
+	if allocs > 2 {
+		t.Fatal(allocs)
+	}
+}
+
+func TestStereographic(t *testing.T) {
+	t.Parallel()
+
+	d := wgs84.WGS84()
+	crs := d.Stereographic(70, -90, proj.PolarStereographicScale(-71, d), 6000000, 6000000)
+
+	// EPSG Guidance Note 7-2, Polar Stereographic (variant B).
+	east, north, _ := wgs84.Transform(wgs84.WGS84LonLat(), crs).Round(2)(120, -75, 0)
+	if east != 7255380.79 || north != 7053389.56 {
+		t.Fatal(east, north)
+	}
+
+	bessel := wgs84.Helmert(6377397.155, 299.1528128, 0, 0, 0, 0, 0, 0, 0)
+	crs = bessel.Stereographic(5.387638888888889, 52.15616055555555, 0.9999079, 155000, 463000)
+
+	// EPSG Guidance Note 7-2, Oblique Stereographic.
+	east, north = crs.Projection.FromLonLat(6, 53, bessel)
+	if math.Abs(east-196105.283) > 0.001 || math.Abs(north-557057.739) > 0.001 {
+		t.Fatal(east, north)
+	}
+
+	// the same example with RD New, and its origin.
+	rd := wgs84.RDNew()
+
+	east, north = rd.Projection.FromLonLat(6, 53, rd.Datum)
+	if math.Abs(east-196105.283) > 0.001 || math.Abs(north-557057.739) > 0.001 {
+		t.Fatal(east, north)
+	}
+
+	if lon, lat := rd.Projection.ToLonLat(155000, 463000, rd.Datum); math.Abs(lon-5.387638888888889) > 1e-12 || math.Abs(lat-52.15616055555555) > 1e-12 {
+		t.Fatal(lon, lat)
+	}
+
+	if !rd.Contains(4.9, 53.1) || !rd.Contains(5.9, 53.45) || rd.Contains(8, 52) {
+		t.Fatal("Netherlands")
+	}
+
+	for _, crs := range []wgs84.ProjectedReferenceSystem{
+		wgs84.AntarcticPolarStereographic(),
+		wgs84.ArcticPolarStereographic(),
+	} {
+		for lon := -180.0; lon <= 180; lon += 7.5 {
+			for lat := 60.0; lat <= 90; lat += 0.5 {
+				phi := lat
+				if !crs.Contains(lon, phi) {
+					phi = -phi
+				}
+
+				east, north := crs.Projection.FromLonLat(lon, phi, crs.Datum)
+				lon2, lat2 := crs.Projection.ToLonLat(east, north, crs.Datum)
+				east2, north2 := crs.Projection.FromLonLat(lon2, lat2, crs.Datum)
+
+				if math.Hypot(east2-east, north2-north) > 0.001 {
+					t.Fatal(crs.Name(), lon, phi, east2-east, north2-north)
+				}
+			}
+		}
+	}
+}
+
+func TestSafeTransformBodies(t *testing.T) {
+	t.Parallel()
+
+	for _, crs := range []wgs84.CoordinateReferenceSystem{
+		wgs84.MarsGeographic(),
+		wgs84.LunarPolarStereoNorth(),
+		wgs84.TitanLonLat(),
+	} {
+		_, _, _, err := wgs84.SafeTransform(crs, wgs84.WGS84LonLat())(0, 80, 0)
+		if !errors.Is(err, wgs84.ErrDifferentBodies) {
+			t.Fatal(err)
+		}
+	}
+
+	_, _, _, err := wgs84.SafeTransform(wgs84.LunarLonLat(), wgs84.LunarPolarStereoNorth())(0, 80, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLambertAzimuthalEqualArea(t *testing.T) {
+	t.Parallel()
+
+	// EPSG Guidance Note 7-2, Lambert Azimuthal Equal Area.
+	east, north, _ := wgs84.Transform(wgs84.ETRS89().LonLat(), wgs84.ETRS89LAEA()).Round(2)(5, 50, 0)
+	if east != 3962799.45 || north != 2999718.85 {
+		t.Fatal(east, north)
+	}
+
+	const r = 6371000
+
+	sphere := wgs84.Datum{Spheroid: wgs84.Helmert(r, math.Inf(1), 0, 0, 0, 0, 0, 0, 0)}
+
+	for _, latf := range []float64{90, -90} {
+		crs := sphere.LambertAzimuthalEqualArea(0, latf, 0, 0)
+
+		east, north := crs.Projection.FromLonLat(90, latf/3, sphere)
+		if want := 2 * r * math.Sin(radian(latf-latf/3)/2); math.Abs(east-math.Abs(want)) > 1e-6 || math.Abs(north) > 1e-6 {
+			t.Fatal(latf, east, north, want)
+		}
+	}
+
+	for _, crs := range []wgs84.ProjectedReferenceSystem{
+		wgs84.WGS84().LambertAzimuthalEqualArea(0, 90, 0, 0),
+		wgs84.WGS84().LambertAzimuthalEqualArea(-45, -90, 0, 0),
+		wgs84.WGS84().LambertAzimuthalEqualArea(-100, -45, 0, 0),
+		wgs84.ETRS89LAEA(),
+	} {
+		p := crs.Projection
+		latf := p.(proj.LambertAzimuthalEqualArea).Latf
+
+		for lon := -170.0; lon <= 170; lon += 10 {
+			for lat := latf - 60; lat <= latf+60; lat += 5 {
+				if math.Abs(lat) > 89 || math.Abs(lat-latf) < 1e-9 {
+					continue
+				}
+
+				east, north := p.FromLonLat(lon, lat, crs.Datum)
+				lon2, lat2 := p.ToLonLat(east, north, crs.Datum)
+
+				if math.Abs(math.Remainder(lon2-lon, 360)) > 1e-7 || math.Abs(lat2-lat) > 1e-7 {
+					t.Fatal(latf, lon, lat, lon2, lat2)
+				}
+			}
+		}
+	}
+}
+
+func radian(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+func TestHotineObliqueMercator(t *testing.T) {
+	t.Parallel()
+
+	// EPSG Guidance Note 7-2, Hotine Oblique Mercator variant A and B
+	// (Timbalai 1948 / RSO Borneo).
+	everest := wgs84.Helmert(6377298.556, 300.8017, 0, 0, 0, 0, 0, 0, 0)
+	lon, lat := 115+48.0/60+19.8196/3600, 5+23.0/60+14.1129/3600
+
+	for _, crs := range []wgs84.ProjectedReferenceSystem{
+		everest.HotineObliqueMercatorA(115, 4, 53.31582047222222, 53.13010236111111, 0.99984, 0, 0),
+		everest.HotineObliqueMercator(115, 4, 53.31582047222222, 53.13010236111111, 0.99984, 590476.87, 442857.65),
+	} {
+		east, north := crs.Projection.FromLonLat(lon, lat, everest)
+		if math.Abs(east-679245.73) > 0.1 || math.Abs(north-596562.78) > 0.1 {
+			t.Fatal(east, north)
+		}
+
+		lon2, lat2 := crs.Projection.ToLonLat(east, north, everest)
+		if math.Abs(lon2-lon) > 1e-9 || math.Abs(lat2-lat) > 1e-9 {
+			t.Fatal(lon2, lat2)
+		}
+	}
+
+	crs := wgs84.MalaysiaRSO()
+
+	for lon := 100.0; lon <= 104.5; lon += 0.5 {
+		for lat := 1.5; lat <= 6.5; lat += 0.5 {
+			east, north := crs.Projection.FromLonLat(lon, lat, crs.Datum)
+			lon2, lat2 := crs.Projection.ToLonLat(east, north, crs.Datum)
+
+			if math.Abs(lon2-lon) > 1e-9 || math.Abs(lat2-lat) > 1e-9 {
+				t.Fatal(lon, lat, lon2, lat2)
+			}
+		}
+	}
+}
+
+func TestKrovak(t *testing.T) {
+	t.Parallel()
+
+	// EPSG Guidance Note 7-2, Krovak and Krovak East North (S-JTSK).
+	bessel := wgs84.Datum{Spheroid: wgs84.Bessel{}}
+	lon, lat := 16+50.0/60+59.179/3600, 50+12.0/60+32.442/3600
+
+	for _, crs := range []wgs84.ProjectedReferenceSystem{wgs84.SJTSK(), wgs84.SJTSKEastNorth()} {
+		east, north := crs.Projection.FromLonLat(lon, lat, bessel)
+		if crs.EPSGCode() == 5514 {
+			east, north = -east, -north
+		}
+
+		if math.Abs(east-568990.99) > 0.01 || math.Abs(north-1050538.63) > 0.01 {
+			t.Fatal(crs.EPSGCode(), east, north)
+		}
+	}
+
+	crs := wgs84.CzechRepublicKrovak()
+
+	for east := -900000.0; east <= -400000; east += 50000 {
+		for north := -1250000.0; north <= -930000; north += 40000 {
+			lon, lat := crs.Projection.ToLonLat(east, north, crs.Datum)
+			east2, north2 := crs.Projection.FromLonLat(lon, lat, crs.Datum)
+
+			if math.Abs(east2-east) > 1e-4 || math.Abs(north2-north) > 1e-4 {
+				t.Fatal(east, north, east2, north2)
+			}
+		}
+	}
+
+	def, err := wgs84.ToProj4String(wgs84.SJTSK())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := wgs84.ParsePROJ(def)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if wgs84.Fingerprint(parsed) != wgs84.Fingerprint(wgs84.SJTSK()) {
+		t.Fatal(def)
+	}
+}
+
+func TestCylindrical(t *testing.T) {
+	t.Parallel()
+
+	// EPSG Guidance Note 7-2, Mercator variant B (Pulkovo 1942 / Caspian Sea
+	// Mercator).
+	crs, err := wgs84.ParsePROJ("+proj=merc +lat_ts=42 +lon_0=51 +a=6378245 +rf=298.3 +units=m")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caspian, _ := crs.(wgs84.ProjectedReferenceSystem)
+
+	east, north := caspian.Projection.FromLonLat(53, 53, caspian.Datum)
+	if math.Abs(east-165704.29) > 0.01 || math.Abs(north-5171848.07) > 0.01 {
+		t.Fatal(east, north)
+	}
+
+	lon, lat := caspian.Projection.ToLonLat(east, north, caspian.Datum)
+	if math.Abs(lon-53) > 1e-9 || math.Abs(lat-53) > 1e-9 {
+		t.Fatal(lon, lat)
+	}
+
+	plate := wgs84.PlateCarree()
+
+	for _, p := range [][2]float64{{-180, -90}, {13.4, 52.5}, {179.9, 89.9}} {
+		east, north := plate.Projection.FromLonLat(p[0], p[1], plate.Datum)
+		if math.Abs(east-radian(p[0])*wgs84.A) > 1e-6 || math.Abs(north-radian(p[1])*wgs84.A) > 1e-6 {
+			t.Fatal(p, east, north)
+		}
+
+		lon, lat := plate.Projection.ToLonLat(east, north, plate.Datum)
+		if math.Abs(lon-p[0]) > 1e-12 || math.Abs(lat-p[1]) > 1e-12 {
+			t.Fatal(p, lon, lat)
+		}
+	}
+
+	east, _ = wgs84.WGS84().Equirectangular(10, 60, 1000, 0).Projection.FromLonLat(11, 0, wgs84.WGS84())
+	if math.Abs(east-1000-radian(1)*wgs84.A/2) > 1e-6 {
+		t.Fatal(east)
+	}
+}
@@ -0,0 +1,68 @@
+package wgs84
+
+import "math"
+
+// RhumbDistance returns the length in meters of the rhumb line (loxodrome)
+// between two geographic coordinates of a Datum.
+func RhumbDistance(lon1, lat1, lon2, lat2 float64, d Datum) float64 {
+	sph := spheroid{a: d.A(), fi: d.Fi()}
+	φ1, φ2 := radian(lat1), radian(lat2)
+	Δλ := radian(math.Mod(lon2-lon1+540, 360) - 180)
+	Δψ := isometricLatitude(φ2, sph) - isometricLatitude(φ1, sph)
+
+	return math.Abs(rhumbRatio(φ1, φ2, sph)) * math.Hypot(Δψ, Δλ)
+}
+
+// RhumbBearing returns the constant bearing in degrees of the rhumb line
+// (loxodrome) between two WGS84 geographic coordinates.
+func RhumbBearing(lon1, lat1, lon2, lat2 float64) float64 {
+	sph := spheroid{a: A, fi: Fi}
+	Δλ := radian(math.Mod(lon2-lon1+540, 360) - 180)
+	Δψ := isometricLatitude(radian(lat2), sph) - isometricLatitude(radian(lat1), sph)
+
+	return math.Mod(degree(math.Atan2(Δλ, Δψ))+360, 360)
+}
+
+// RhumbDestination returns the geographic coordinate reached from a
+// geographic coordinate of a Datum after distance meters on the rhumb line
+// (loxodrome) with the constant bearing in degrees.
+//
+// A rhumb line spirals around a pole without reaching it. Distances beyond
+// the pole end at the pole, where the start longitude is returned.
+func RhumbDestination(lon, lat, bearing, distance float64, d Datum) (destLon, destLat float64) {
+	sph := spheroid{a: d.A(), fi: d.Fi()}
+	θ := radian(bearing)
+	φ1 := radian(lat)
+	quarter := meridianArc(math.Pi/2, sph)
+	m := meridianArc(φ1, sph) + distance*math.Cos(θ)
+
+	if math.Abs(m) >= quarter {
+		return lon, math.Copysign(90, m)
+	}
+
+	φ2 := inverseMeridianArc(m, sph)
+	Δλ := distance * math.Sin(θ) / rhumbRatio(φ1, φ2, sph)
+
+	return math.Mod(lon+degree(Δλ)+540, 360) - 180, degree(φ2)
+}
+
+// rhumbRatio returns the ratio of the meridian arc to the isometric latitude
+// between two latitudes in radians.
+//
+// For latitudes closer than 1e-6 radians the difference quotient cancels, so
+// the derivative N*cos(φ) at the mean latitude is used, which has a relative
+// error of the order of the squared difference.
+func rhumbRatio(φ1, φ2 float64, sph spheroid) float64 {
+	if math.Abs(φ2-φ1) < 1e-6 {
+		φ := (φ1 + φ2) / 2
+
+		return _N(φ, sph) * math.Cos(φ)
+	}
+
+	return (meridianArc(φ2, sph) - meridianArc(φ1, sph)) / (isometricLatitude(φ2, sph) - isometricLatitude(φ1, sph))
+}
+
+// isometricLatitude returns the Mercator latitude of a latitude in radians.
+func isometricLatitude(φ float64, s spheroid) float64 {
+	return radian(s.ellipsoid().IsometricLatitude(degree(φ)))
+}
@@ -0,0 +1,33 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestRhumb(t *testing.T) {
+	t.Parallel()
+
+	d := wgs84.WGS84()
+
+	lon, lat := wgs84.RhumbDestination(9, 89, 10, 500000, d)
+	if lon != 9 || lat != 90 {
+		t.Fatal(lon, lat)
+	}
+
+	lon, lat = wgs84.RhumbDestination(9, -89, 200, 500000, d)
+	if lon != 9 || lat != -90 {
+		t.Fatal(lon, lat)
+	}
+
+	for _, Δ := range []float64{0, 1e-10, 1e-7, 1e-5, 1e-3, 1} {
+		dist := wgs84.RhumbDistance(9, 52, 10, 52+Δ, d)
+		lon, lat := wgs84.RhumbDestination(9, 52, wgs84.RhumbBearing(9, 52, 10, 52+Δ), dist, d)
+
+		if math.IsNaN(dist) || math.Abs(lon-10) > 1e-9 || math.Abs(lat-52-Δ) > 1e-9 {
+			t.Fatal(Δ, dist, lon, lat)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package wgs84
+
+import "math"
+
+// SimplifyProjected removes points of a polyline in projected coordinates with
+// the Ramer-Douglas-Peucker algorithm.
+//
+// The Euclidean distances of the points to the segments between the remaining
+// points are compared to toleranceMeters. Use SimplifyPolyline for geographic
+// coordinates.
+func SimplifyProjected(easting, northing []float64, toleranceMeters float64) ([]float64, []float64) {
+	n := len(easting)
+	if len(northing) < n {
+		n = len(northing)
+	}
+
+	if n < 3 {
+		return append([]float64(nil), easting[:n]...), append([]float64(nil), northing[:n]...)
+	}
+
+	keep := make([]bool, n)
+	keep[0], keep[n-1] = true, true
+
+	var simplify func(first, last int)
+
+	simplify = func(first, last int) {
+		index, maxDist := -1, toleranceMeters
+
+		for i := first + 1; i < last; i++ {
+			dist := segmentDistance(easting[i], northing[i],
+				easting[first], northing[first], easting[last], northing[last])
+			if dist > maxDist {
+				index, maxDist = i, dist
+			}
+		}
+
+		if index < 0 {
+			return
+		}
+
+		keep[index] = true
+
+		simplify(first, index)
+		simplify(index, last)
+	}
+
+	simplify(0, n-1)
+
+	var east, north []float64
+
+	for i := 0; i < n; i++ {
+		if keep[i] {
+			east = append(east, easting[i])
+			north = append(north, northing[i])
+		}
+	}
+
+	return east, north
+}
+
+// segmentDistance returns the Euclidean distance of a point to the segment
+// between two points.
+func segmentDistance(x, y, x1, y1, x2, y2 float64) float64 {
+	dx, dy := x2-x1, y2-y1
+
+	t := 0.0
+	if l2 := dx*dx + dy*dy; l2 > 0 {
+		t = math.Max(0, math.Min(1, ((x-x1)*dx+(y-y1)*dy)/l2))
+	}
+
+	return math.Hypot(x-x1-t*dx, y-y1-t*dy)
+}
@@ -1,6 +1,11 @@
 package wgs84
 
-import "math"
+import (
+	"math"
+
+	"github.com/wroge/wgs84/datum"
+	"github.com/wroge/wgs84/geod"
+)
 
 type spheroid struct {
 	a, fi float64
@@ -14,6 +19,10 @@ func (s spheroid) Fi() float64 {
 	return s.fi
 }
 
+func (s spheroid) ellipsoid() geod.Ellipsoid {
+	return geod.Ellipsoid{A: s.A(), Fi: s.Fi()}
+}
+
 func (s spheroid) a2() float64 {
 	return s.A() * s.A()
 }
@@ -71,53 +80,31 @@ const (
 )
 
 // GRS80 is a spheroid used by several geodetic datums.
-type GRS80 struct{}
-
-// A returns the major axis of the spheroid.
-func (GRS80) A() float64 {
-	return 6378137
-}
-
-// Fi returns the inverse Flattening of the spheroid.
-func (GRS80) Fi() float64 {
-	return 298.257222101
-}
+type GRS80 = datum.GRS80
 
 // Airy is a spheroid used by several geodetic datums.
-type Airy struct{}
+type Airy = datum.Airy
 
-// A returns the major axis of the spheroid.
-func (Airy) A() float64 {
-	return 6377563.396
-}
+// Bessel is a spheroid used by several geodetic datums.
+type Bessel = datum.Bessel
 
-// Fi returns the inverse Flattening of the spheroid.
-func (Airy) Fi() float64 {
-	return 299.3249646
-}
+// Clarke1866 is a spheroid used by several geodetic datums.
+type Clarke1866 = datum.Clarke1866
 
-// Bessel is a spheroid used by several geodetic datums.
-type Bessel struct{}
+// International1924 is a spheroid used by several geodetic datums.
+type International1924 = datum.International1924
 
-// A returns the major axis of the spheroid.
-func (Bessel) A() float64 {
-	return 6377397.155
-}
+// Clarke1858 is a spheroid used by several geodetic datums.
+type Clarke1858 = datum.Clarke1858
 
-// Fi returns the inverse Flattening of the spheroid.
-func (Bessel) Fi() float64 {
-	return 299.1528128
-}
+// AiryModified is a spheroid used by several geodetic datums.
+type AiryModified = datum.AiryModified
 
-// Clarke1866 is a spheroid used by several geodetic datums.
-type Clarke1866 struct{}
+// Clarke1880IGN is a spheroid used by several geodetic datums.
+type Clarke1880IGN = datum.Clarke1880IGN
 
-// A returns the major axis of the spheroid.
-func (Clarke1866) A() float64 {
-	return 6378206.4
-}
+// GRS67Modified is a spheroid used by several geodetic datums.
+type GRS67Modified = datum.GRS67Modified
 
-// Fi returns the inverse Flattening of the spheroid.
-func (Clarke1866) Fi() float64 {
-	return 294.9786982139006
-}
+// Krassowsky1940 is a spheroid used by several geodetic datums.
+type Krassowsky1940 = datum.Krassowsky1940
@@ -0,0 +1,63 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestSWEREF99(t *testing.T) {
+	t.Parallel()
+
+	tm := wgs84.SWEREF99TM()
+
+	if east, north := tm.Projection.FromLonLat(15, 0, tm.Datum); east != 500000 || north != 0 {
+		t.Fatal(east, north)
+	}
+
+	// SWEREF99 15 00 and SWEREF99 TM differ by the scale factor and the false
+	// easting.
+	zone := wgs84.SWEREF99Zone(15)
+
+	for lat := 55.5; lat <= 69; lat += 0.5 {
+		for lon := 13.5; lon <= 16.5; lon += 0.5 {
+			east, north, _ := wgs84.Transform(wgs84.SWEREF99().LonLat(), tm)(lon, lat, 0)
+			e, n, _ := wgs84.Transform(wgs84.SWEREF99().LonLat(), zone)(lon, lat, 0)
+
+			if math.Abs(east-500000-0.9996*(e-150000)) > 1e-6 || math.Abs(north-0.9996*n) > 1e-6 {
+				t.Fatal(lon, lat, east, north, e, n)
+			}
+		}
+	}
+
+	for _, c := range []struct {
+		lon  float64
+		code int
+		name string
+	}{
+		{12, 3007, "SWEREF99 12 00"},
+		{13.5, 3008, "SWEREF99 13 30"},
+		{14.25, 3012, "SWEREF99 14 15"},
+		{23.25, 3018, "SWEREF99 23 15"},
+	} {
+		crs := wgs84.SWEREF99Zone(c.lon)
+		if crs.EPSGCode() != c.code || crs.Name() != c.name {
+			t.Fatal(crs.EPSGCode(), crs.Name())
+		}
+
+		if !crs.Contains(c.lon-1.4, 60) || crs.Contains(c.lon-1.6, 60) || crs.Contains(c.lon, 70) {
+			t.Fatal(c.lon)
+		}
+
+		if east, _ := crs.Projection.FromLonLat(c.lon, 60, crs.Datum); east != 150000 {
+			t.Fatal(east)
+		}
+	}
+
+	for _, lon := range []float64{14, 15.5, 24.75, math.NaN()} {
+		if crs := wgs84.SWEREF99Zone(lon); crs.Projection != nil {
+			t.Fatal(lon, crs)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package wgs84_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestSwissLV95(t *testing.T) {
+	t.Parallel()
+
+	lv95, lv03 := wgs84.SwissLV95(), wgs84.SwissLV03()
+
+	// The projection centre is the old observatory of Bern.
+	east, north := lv95.Projection.FromLonLat(7.439583333333333, 46.952405555555565, lv95.Datum)
+	if math.Abs(east-2600000) > 1e-6 || math.Abs(north-1200000) > 1e-6 {
+		t.Fatal(east, north)
+	}
+
+	// Example of the approximate formulas of swisstopo, accurate to about 1
+	// meter.
+	lon, lat := 8+43.0/60+49.79/3600, 46+2.0/60+38.87/3600
+
+	east, north, _ = wgs84.Transform(wgs84.WGS84LonLat(), lv95)(lon, lat, 0)
+	if math.Abs(east-2699999.76) > 1.5 || math.Abs(north-1099999.97) > 1.5 {
+		t.Fatal(east, north)
+	}
+
+	e, n, _ := wgs84.Transform(wgs84.WGS84LonLat(), lv03)(lon, lat, 0)
+	if math.Abs(e-699999.76) > 1.5 || math.Abs(n-99999.97) > 1.5 {
+		t.Fatal(e, n)
+	}
+
+	if e2, n2 := wgs84.LV95ToLV03(east, north); math.Abs(e2-e) > 0.1 || math.Abs(n2-n) > 0.1 {
+		t.Fatal(e2, n2, e, n)
+	}
+
+	for lon := 6.0; lon <= 10.5; lon += 0.5 {
+		for lat := 46.0; lat <= 47.5; lat += 0.5 {
+			east, north := lv95.Projection.FromLonLat(lon, lat, lv95.Datum)
+			lon2, lat2 := lv95.Projection.ToLonLat(east, north, lv95.Datum)
+
+			if math.Abs(lon2-lon) > 1e-9 || math.Abs(lat2-lat) > 1e-9 {
+				t.Fatal(lon, lat, lon2, lat2)
+			}
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package wgs84
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Tissot computes Tissot's indicatrix of a projected Coordinate Reference
+// System at a geographic coordinate of its Datum.
+//
+// It returns the semi-major axis a and the semi-minor axis b of the
+// ellipse, the maximum angular deviation omega in degrees and the areal
+// scale s. The values are computed from the numerical Jacobian of the
+// Projection.
+//
+// Returns NaN if the Projection is nil.
+func Tissot(crs ProjectedReferenceSystem, lon, lat float64) (a, b, omega, s float64) {
+	p := crs.Projection
+	if p == nil {
+		return math.NaN(), math.NaN(), math.NaN(), math.NaN()
+	}
+
+	dEdλ, dNdλ, dEdφ, dNdφ := jacobian(p, crs.Datum, lon, lat)
+
+	h2 := dEdφ*dEdφ + dNdφ*dNdφ
+	k2 := dEdλ*dEdλ + dNdλ*dNdλ
+	s = math.Abs(dEdλ*dNdφ - dEdφ*dNdλ)
+
+	ai := math.Sqrt(h2 + k2 + 2*s)
+	bi := math.Sqrt(math.Max(h2+k2-2*s, 0))
+	a = (ai + bi) / 2
+	b = (ai - bi) / 2
+	omega = degree(2 * math.Asin(bi/ai))
+
+	return a, b, omega, s
+}
+
+// jacobian returns the partial derivatives of the Projection by the ground
+// distances along the parallel and the meridian at a geographic coordinate.
+func jacobian(p Projection, d Datum, lon, lat float64) (dEdλ, dNdλ, dEdφ, dNdφ float64) {
+	const step = 1e-5
+
+	sph := spheroid{a: d.A(), fi: d.Fi()}
+
+	e1, n1 := p.FromLonLat(lon-step, lat, d)
+	e2, n2 := p.FromLonLat(lon+step, lat, d)
+	e3, n3 := p.FromLonLat(lon, lat-step, d)
+	e4, n4 := p.FromLonLat(lon, lat+step, d)
+
+	φ := radian(lat)
+	w := 1 - sph.e2()*sin2(φ)
+	M := sph.A() * (1 - sph.e2()) / math.Pow(w, 1.5)
+	N := sph.A() / math.Sqrt(w)
+
+	dEdλ = (e2 - e1) / radian(2*step) / (N * math.Cos(φ))
+	dNdλ = (n2 - n1) / radian(2*step) / (N * math.Cos(φ))
+	dEdφ = (e4 - e3) / radian(2*step) / M
+	dNdφ = (n4 - n3) / radian(2*step) / M
+
+	return dEdλ, dNdλ, dEdφ, dNdφ
+}
+
+// GroundDistance returns the ground distance on the ellipsoid for a
+// projected distance at a projected coordinate, for example for scale bars.
+//
+// The projected distance is divided by the local scale factor, the square
+// root of the areal scale of Tissot's indicatrix. For conformal projections
+// it equals the point scale factor in all directions.
+//
+// Returns NaN if the Projection is nil.
+func GroundDistance(crs ProjectedReferenceSystem, easting, northing, projectedDistanceMeters float64) float64 {
+	if crs.Projection == nil {
+		return math.NaN()
+	}
+
+	lon, lat := crs.Projection.ToLonLat(easting, northing, crs.Datum)
+	_, _, _, s := Tissot(crs, lon, lat)
+
+	return projectedDistanceMeters / math.Sqrt(s)
+}
+
+// DistortionGrid evaluates Tissot on a regular grid of geographic coordinates.
+//
+// The grids are indexed by [row][col], where the first row is at minLat and
+// the first column at minLon. The scaleFactors hold the areal scale and the
+// angularDistortions the maximum angular deviation in degrees. Coordinates
+// outside of the Area of the Coordinate Reference System are NaN.
+func DistortionGrid(crs ProjectedReferenceSystem, minLon, minLat, maxLon, maxLat float64,
+	cols, rows int,
+) (scaleFactors, angularDistortions [][]float64) {
+	scaleFactors = make([][]float64, rows)
+	angularDistortions = make([][]float64, rows)
+
+	for r := 0; r < rows; r++ {
+		scaleFactors[r] = make([]float64, cols)
+		angularDistortions[r] = make([]float64, cols)
+		lat := gridStep(minLat, maxLat, r, rows)
+
+		for c := 0; c < cols; c++ {
+			lon := gridStep(minLon, maxLon, c, cols)
+
+			if !crs.Contains(lon, lat) {
+				scaleFactors[r][c], angularDistortions[r][c] = math.NaN(), math.NaN()
+
+				continue
+			}
+
+			_, _, omega, s := Tissot(crs, lon, lat)
+			scaleFactors[r][c], angularDistortions[r][c] = s, omega
+		}
+	}
+
+	return scaleFactors, angularDistortions
+}
+
+// TissotSVG draws Tissot's indicatrices of a projected Coordinate Reference
+// System on a grid of geographic coordinates as an SVG document.
+//
+// The grid starts at minLon and minLat and has a spacing of gridSpacing
+// degrees. Coordinates outside of the Area of the Coordinate Reference System
+// are skipped. Each ellipse is the projection of a circle with a radius of
+// scale meters on the ellipsoid, drawn at its projected coordinate. The
+// viewBox of the document fits all ellipses, with north up.
+func TissotSVG(crs ProjectedReferenceSystem, minLon, minLat, maxLon, maxLat float64,
+	gridSpacing float64, scale float64,
+) string {
+	var (
+		b                      strings.Builder
+		minX, minY, maxX, maxY = math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	)
+
+	if crs.Projection != nil && gridSpacing > 0 {
+		cols := int(math.Floor((maxLon-minLon)/gridSpacing+1e-9)) + 1
+		rows := int(math.Floor((maxLat-minLat)/gridSpacing+1e-9)) + 1
+
+		for r := 0; r < rows; r++ {
+			lat := minLat + float64(r)*gridSpacing
+
+			for c := 0; c < cols; c++ {
+				lon := minLon + float64(c)*gridSpacing
+				if !crs.Contains(lon, lat) {
+					continue
+				}
+
+				x, y := crs.Projection.FromLonLat(lon, lat, crs.Datum)
+				dEdλ, dNdλ, dEdφ, dNdφ := jacobian(crs.Projection, crs.Datum, lon, lat)
+
+				if math.IsNaN(x + y + dEdλ + dNdλ + dEdφ + dNdφ) {
+					continue
+				}
+
+				// SVG coordinates point down, so northings are negated.
+				y = -y
+				dx := scale * math.Hypot(dEdλ, dEdφ)
+				dy := scale * math.Hypot(dNdλ, dNdφ)
+				minX, maxX = math.Min(minX, x-dx), math.Max(maxX, x+dx)
+				minY, maxY = math.Min(minY, y-dy), math.Max(maxY, y+dy)
+
+				fmt.Fprintf(&b, "<circle r=\"1\" vector-effect=\"non-scaling-stroke\" transform=\"matrix(%g %g %g %g %g %g)\"/>\n",
+					scale*dEdλ, -scale*dNdλ, scale*dEdφ, -scale*dNdφ, x, y)
+			}
+		}
+	}
+
+	if minX > maxX {
+		minX, minY, maxX, maxY = 0, 0, 0, 0
+	}
+
+	return fmt.Sprintf("<svg xmlns=\"http://www.w3.org/2000/svg\" viewBox=\"%g %g %g %g\">\n"+
+		"<g fill=\"none\" stroke=\"black\" stroke-width=\"1\">\n"+
+		"%s</g>\n</svg>\n", minX, minY, maxX-minX, maxY-minY, b.String())
+}
+
+func gridStep(from, to float64, i, n int) float64 {
+	if n < 2 {
+		return (from + to) / 2
+	}
+
+	return from + (to-from)*float64(i)/float64(n-1)
+}
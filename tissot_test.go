@@ -0,0 +1,55 @@
+package wgs84_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/wroge/wgs84"
+)
+
+func TestTissotSVG(t *testing.T) {
+	t.Parallel()
+
+	var doc struct {
+		ViewBox string `xml:"viewBox,attr"`
+		Circles []struct {
+			Transform string `xml:"transform,attr"`
+		} `xml:"g>circle"`
+	}
+
+	// The Area of Web Mercator ends at 85.06°, so the northernmost row is
+	// skipped.
+	svg := wgs84.TissotSVG(wgs84.WebMercator(), -180, -60, 180, 90, 30, 100000)
+	if err := xml.Unmarshal([]byte(svg), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(doc.Circles) != 13*5 {
+		t.Fatal(len(doc.Circles))
+	}
+
+	for _, c := range doc.Circles {
+		var a, b, cc, d, e, f float64
+
+		if _, err := fmt.Sscanf(c.Transform, "matrix(%g %g %g %g %g %g)", &a, &b, &cc, &d, &e, &f); err != nil {
+			t.Fatal(err)
+		}
+
+		// Web Mercator is nearly conformal, the circles are only scaled.
+		if math.Abs(a+d) > 0.01*a || math.Abs(b) > 1 || math.Abs(cc) > 1 || a < 99999 {
+			t.Fatal(c.Transform)
+		}
+	}
+
+	var x, y, w, h float64
+	if _, err := fmt.Sscanf(doc.ViewBox, "%g %g %g %g", &x, &y, &w, &h); err != nil || w < 2*20037508 || y > -7.0e6 {
+		t.Fatal(doc.ViewBox, err)
+	}
+
+	if svg := wgs84.TissotSVG(wgs84.ProjectedReferenceSystem{}, -180, -90, 180, 90, 30, 1); strings.Contains(svg, "<circle") {
+		t.Fatal(svg)
+	}
+}
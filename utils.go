@@ -44,6 +44,125 @@ func xyzToLonLat(x, y, z, a, fi float64) (lon, lat, h float64) {
 	return lon, lat, h
 }
 
+// GeodeticToGeocentricLat converts the geodetic latitude in degrees of a
+// spheroid with the inverse flattening fi to the geocentric latitude.
+//
+// The geodetic latitude is the angle between the equator and the normal of
+// the spheroid, like the latitudes of a GeographicReferenceSystem. The
+// geocentric latitude is the angle between the equator and the line to the
+// center of the spheroid. It's smaller in magnitude, by up to 0.19° on WGS84.
+// A sphere has an infinite inverse flattening, where both are equal.
+func GeodeticToGeocentricLat(lat, fi float64) float64 {
+	φ, f := radian(lat), 1/fi
+
+	return degree(math.Atan2((1-f)*(1-f)*math.Sin(φ), math.Cos(φ)))
+}
+
+// GeocentricToGeodeticLat is the inverse of GeodeticToGeocentricLat.
+func GeocentricToGeodeticLat(lat, fi float64) float64 {
+	ψ, f := radian(lat), 1/fi
+
+	return degree(math.Atan2(math.Sin(ψ), (1-f)*(1-f)*math.Cos(ψ)))
+}
+
+// GeodeticToParametricLat converts the geodetic latitude in degrees of a
+// spheroid with the inverse flattening fi to the parametric or reduced
+// latitude.
+//
+// The parametric latitude is the latitude of the point on a sphere with the
+// radius of the semi-major axis, that is projected parallel to the axis onto
+// the spheroid. It lies between the geocentric and the geodetic latitude and
+// is used in the geodesic formulas of Vincenty.
+func GeodeticToParametricLat(lat, fi float64) float64 {
+	return degree(ParametricLatitude(radian(lat), spheroid{fi: fi}.e2()))
+}
+
+// ParametricToGeodeticLat is the inverse of GeodeticToParametricLat.
+func ParametricToGeodeticLat(lat, fi float64) float64 {
+	return degree(GeodesicLatitude(radian(lat), spheroid{fi: fi}.e2()))
+}
+
+// ParametricLatitude is GeodeticToParametricLat in radians for a spheroid
+// with the squared eccentricity e2, like in the formulas of ellipsoidal arc
+// lengths.
+func ParametricLatitude(geodLatRad, e2 float64) float64 {
+	return math.Atan2(math.Sqrt(1-e2)*math.Sin(geodLatRad), math.Cos(geodLatRad))
+}
+
+// GeodesicLatitude is the inverse of ParametricLatitude. It returns the
+// geodetic latitude in radians of a parametric latitude in radians.
+func GeodesicLatitude(parametricLatRad, e2 float64) float64 {
+	return math.Atan2(math.Sin(parametricLatRad), math.Sqrt(1-e2)*math.Cos(parametricLatRad))
+}
+
+// AuthalicLatitude returns the authalic latitude in radians of a geodetic
+// latitude in radians on a spheroid with the squared eccentricity e2.
+//
+// The authalic latitude is the latitude on the sphere with the same surface
+// as the spheroid, where the areas between the equator and the parallels are
+// equal. It's used by equal-area projections.
+func AuthalicLatitude(geodLatRad, e2 float64) float64 {
+	if e2 == 0 || math.Abs(geodLatRad) >= math.Pi/2 {
+		return geodLatRad
+	}
+
+	return math.Asin(math.Max(-1, math.Min(1, authalicQ(geodLatRad, e2)/authalicQ(math.Pi/2, e2))))
+}
+
+// InverseAuthalicLatitude is the inverse of AuthalicLatitude. The geodetic
+// latitude is found by the iteration of Snyder (3-16).
+func InverseAuthalicLatitude(authLatRad, e2 float64) float64 {
+	if e2 == 0 || math.Abs(authLatRad) >= math.Pi/2 {
+		return authLatRad
+	}
+
+	e := math.Sqrt(e2)
+	q := authalicQ(math.Pi/2, e2) * math.Sin(authLatRad)
+	φ := authLatRad
+
+	for i := 0; i < 20; i++ {
+		sinφ := math.Sin(φ)
+		w := 1 - e2*sinφ*sinφ
+		Δφ := w * w / (2 * math.Cos(φ)) *
+			(q/(1-e2) - sinφ/w + 1/(2*e)*math.Log((1-e*sinφ)/(1+e*sinφ)))
+		φ += Δφ
+
+		if math.Abs(Δφ) < 1e-15 {
+			break
+		}
+	}
+
+	return φ
+}
+
+// authalicQ is q of Snyder (3-12).
+func authalicQ(φ, e2 float64) float64 {
+	sinφ := math.Sin(φ)
+	if e2 == 0 {
+		return 2 * sinφ
+	}
+
+	e := math.Sqrt(e2)
+
+	return (1 - e2) * (sinφ/(1-e2*sinφ*sinφ) - 1/(2*e)*math.Log((1-e*sinφ)/(1+e*sinφ)))
+}
+
+func meridianArc(φ float64, s spheroid) float64 {
+	return s.ellipsoid().MeridianArc(degree(φ))
+}
+
+func inverseMeridianArc(m float64, s spheroid) float64 {
+	return radian(s.ellipsoid().InverseMeridianArc(m))
+}
+
 func _N(φ float64, s spheroid) float64 {
 	return s.A() / math.Sqrt(1-s.e2()*math.Pow(math.Sin(φ), 2))
 }
+
+func dot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
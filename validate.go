@@ -0,0 +1,58 @@
+package wgs84
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrRoundTrip is returned by ValidateByBoundary for points that don't
+// transform back to within 1 meter.
+var ErrRoundTrip = errors.New("round trip error exceeds 1 m")
+
+// ValidateByBoundary checks the round trip of a CoordinateReferenceSystem near
+// the boundary of its Area, where singularities of projections like zone
+// edges are found.
+//
+// The world is sampled on a grid of nSamples longitudes and nSamples/2
+// latitudes, at least 4 by 2. Each contained point with a neighbour outside
+// of the Area, or at the edge of the world, is transformed from WGS84 to the
+// Coordinate Reference System and back. An error wrapping ErrRoundTrip is
+// returned for the first point that moves more than 1 meter. Areas smaller
+// than the grid cells may not be sampled at all.
+func ValidateByBoundary(crs CoordinateReferenceSystem, nSamples int) error {
+	if isNil(crs) {
+		return ErrNoCoordinateReferenceSystem
+	}
+
+	cols := nSamples
+	if cols < 4 {
+		cols = 4
+	}
+
+	rows := cols / 2
+	lon := func(i int) float64 { return -180 + 360*(float64(i)+0.5)/float64(cols) }
+	lat := func(j int) float64 { return -90 + 180*(float64(j)+0.5)/float64(rows) }
+
+	contains := func(i, j int) bool {
+		return i >= 0 && i < cols && j >= 0 && j < rows && crs.Contains(lon(i), lat(j))
+	}
+
+	for j := 0; j < rows; j++ {
+		for i := 0; i < cols; i++ {
+			if !contains(i, j) || contains(i-1, j) && contains(i+1, j) && contains(i, j-1) && contains(i, j+1) {
+				continue
+			}
+
+			x, y, z := WGS84LonLat().ToWGS84(lon(i), lat(j), 0)
+			x2, y2, z2 := crs.ToWGS84(crs.FromWGS84(x, y, z))
+
+			// NaN is not within 1 meter either.
+			if d := math.Sqrt((x2-x)*(x2-x) + (y2-y)*(y2-y) + (z2-z)*(z2-z)); !(d <= 1) {
+				return fmt.Errorf("%w: %.3f m at %v,%v", ErrRoundTrip, d, lon(i), lat(j))
+			}
+		}
+	}
+
+	return nil
+}
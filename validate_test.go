@@ -0,0 +1,55 @@
+package wgs84_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/wroge/wgs84"
+	"github.com/wroge/wgs84/proj"
+)
+
+// edgeProjection is a Transverse Mercator projection with a wrong inverse
+// west of 1°, near the western edge of UTM zone 31.
+type edgeProjection struct {
+	proj.TransverseMercator
+}
+
+func (p edgeProjection) ToLonLat(east, north float64, s wgs84.Spheroid) (lon, lat float64) {
+	lon, lat = p.TransverseMercator.ToLonLat(east, north, s)
+	if lon < 1 {
+		lat += 0.001
+	}
+
+	return lon, lat
+}
+
+func TestValidateByBoundary(t *testing.T) {
+	t.Parallel()
+
+	for _, crs := range []wgs84.CoordinateReferenceSystem{
+		wgs84.UTM(32, true),
+		wgs84.ETRS89LAEA(),
+		wgs84.NZTM2000(),
+		wgs84.WGS84LonLat(),
+		wgs84.WGS84XYZ(),
+	} {
+		if err := wgs84.ValidateByBoundary(crs, 360); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	utm := wgs84.UTM(31, true)
+	edge := wgs84.ProjectedReferenceSystem{
+		Datum:      utm.Datum,
+		Projection: edgeProjection{TransverseMercator: utm.Projection.(proj.TransverseMercator)},
+		Area:       utm.Area,
+	}
+
+	if err := wgs84.ValidateByBoundary(edge, 360); !errors.Is(err, wgs84.ErrRoundTrip) {
+		t.Fatal(err)
+	}
+
+	if err := wgs84.ValidateByBoundary(nil, 360); !errors.Is(err, wgs84.ErrNoCoordinateReferenceSystem) {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,105 @@
+package wgs84
+
+import (
+	"errors"
+	"io"
+	"strconv"
+)
+
+// ErrInvalidGeometry is returned by the WKTWriter for calls that do not
+// belong to the current geometry.
+var ErrInvalidGeometry = errors.New("invalid geometry")
+
+// WKTWriter streams geometries as ISO WKT text with WGS84 geographic
+// coordinates.
+//
+// The coordinates are transformed on the fly from a Coordinate Reference
+// System, so large datasets don't have to be materialized in memory.
+type WKTWriter struct {
+	w         io.Writer
+	transform Func
+	open      bool
+	count     int
+}
+
+// NewWKTWriter returns a WKTWriter writing to w. The coordinates are
+// transformed from the CoordinateReferenceSystem from.
+func NewWKTWriter(w io.Writer, from CoordinateReferenceSystem) *WKTWriter {
+	return &WKTWriter{
+		w:         w,
+		transform: Transform(from, WGS84LonLat()),
+	}
+}
+
+// WritePoint writes a POINT Z geometry.
+func (ww *WKTWriter) WritePoint(x, y, z float64) error {
+	if ww.open {
+		return ErrInvalidGeometry
+	}
+
+	_, err := io.WriteString(ww.w, "POINT Z ("+ww.coord(x, y, z)+")\n")
+
+	return err
+}
+
+// StartLineString starts a LINESTRING Z geometry. The coordinates are added
+// with WriteCoord and the geometry is closed with EndGeometry.
+func (ww *WKTWriter) StartLineString() error {
+	if ww.open {
+		return ErrInvalidGeometry
+	}
+
+	ww.open = true
+	ww.count = 0
+
+	_, err := io.WriteString(ww.w, "LINESTRING Z")
+
+	return err
+}
+
+// WriteCoord adds a coordinate to the current geometry.
+func (ww *WKTWriter) WriteCoord(x, y, z float64) error {
+	if !ww.open {
+		return ErrInvalidGeometry
+	}
+
+	s := ww.coord(x, y, z)
+	if ww.count > 0 {
+		s = ", " + s
+	} else {
+		s = " (" + s
+	}
+
+	ww.count++
+
+	_, err := io.WriteString(ww.w, s)
+
+	return err
+}
+
+// EndGeometry closes the current geometry. A geometry without coordinates is
+// written as EMPTY.
+func (ww *WKTWriter) EndGeometry() error {
+	if !ww.open {
+		return ErrInvalidGeometry
+	}
+
+	ww.open = false
+
+	end := ")\n"
+	if ww.count == 0 {
+		end = " EMPTY\n"
+	}
+
+	_, err := io.WriteString(ww.w, end)
+
+	return err
+}
+
+func (ww *WKTWriter) coord(x, y, z float64) string {
+	lon, lat, h := ww.transform(x, y, z)
+
+	return strconv.FormatFloat(lon, 'f', -1, 64) + " " +
+		strconv.FormatFloat(lat, 'f', -1, 64) + " " +
+		strconv.FormatFloat(h, 'f', -1, 64)
+}